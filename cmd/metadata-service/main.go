@@ -21,16 +21,32 @@ func main() {
 	caPath := flag.String("caPath", "", "path to CA certificate")
 	keyPath := flag.String("keyPath", "", "path to client private key")
 	certPath := flag.String("certPath", "", "path to client certificate")
+	requireClientCert := flag.Bool("requireClientCert", false, "Require gRPC clients to present a certificate issued by caPath (mutual TLS)")
 	backupFile := flag.String("backupFile", "/data/metadata.json", "file that metadata is persisted to and loaded from at startup")
 	backupFolder := flag.String("backupFolder", "/data", "Folder used to store backup files")
+	storeBackend := flag.String("storeBackend", "json", "Storage backend used in backupFolder (json, bbolt or sqlite)")
 	specFilePath := flag.String("openapiSpec", "/opt/openapi.yaml", "The location of the OpenAPI spec file")
 	allowedCorsOrigins := flag.String("allowedCorsOrigins", "", "Comma separated list of allowed CORS origins")
 	basePath := flag.String("basePath", "", "The rest server basePath (REST API prefix)")
 	restPort := flag.Int("restPort", 9988, "port that REST service runs on")
 	grpcPort := flag.Int("grpcPort", 9987, "The endpoint of the gRPC server")
 	opaPort := flag.Int("opaPort", 9986, "The endpoint of the Open Policy Agent")
+	metricsPort := flag.Int("metricsPort", 9989, "port that the Prometheus metrics are served on, internal to the cluster")
+	opaPolicyFile := flag.String("opaPolicyFile", "", "Rego policy evaluated in process instead of querying the Open Policy Agent on opaPort")
+	auditLog := flag.String("auditLog", "/data/audit/audit.log", "File recording the changes to the metadata (empty disables the audit)")
+	auditMaxSize := flag.Int64("auditMaxSize", manager.DefaultAuditMaxSize, "Rotate the audit log once it reaches that many bytes")
+	auditMaxFiles := flag.Int("auditMaxFiles", manager.DefaultAuditMaxFiles, "Number of audit log files kept, including the current one")
+	authzCacheTTL := flag.Duration("authzCacheTTL", manager.DefaultAuthzCacheTTL, "Reuse the authorization decisions of a caller for that long (0 disables the cache)")
+	valueTTL := flag.Duration("valueTTL", 0, "Expire the values not asserted for that long (0 keeps them forever)")
+	projectValueTTLs := flag.String("projectValueTTLs", "", "Comma separated list of project=ttl overriding valueTTL")
+	janitorInterval := flag.Duration("janitorInterval", manager.DefaultJanitorInterval, "How often the stale values are expired")
 	flag.Parse()
 
+	projectTTLs, err := manager.ParseProjectTTLs(*projectValueTTLs)
+	if err != nil {
+		log.Fatalf("Invalid -projectValueTTLs: %v", err)
+	}
+
 	// create a channel to manage the servers lifecycle
 	doneChannel := make(chan bool)
 
@@ -49,14 +65,25 @@ func main() {
 		CAPath:             *caPath,
 		KeyPath:            *keyPath,
 		CertPath:           *certPath,
+		RequireClientCert:  *requireClientCert,
 		GRPCPort:           *grpcPort,
 		RestPort:           *restPort,
 		OPAPort:            *opaPort,
+		MetricsPort:        *metricsPort,
+		PolicyFile:         *opaPolicyFile,
+		AuthzCacheTTL:      *authzCacheTTL,
+		AuditLog:           *auditLog,
+		AuditMaxSize:       *auditMaxSize,
+		AuditMaxFiles:      *auditMaxFiles,
 		BasePath:           *basePath,
 		AllowedCorsOrigins: *allowedCorsOrigins,
 		BackupFile:         *backupFile,
 		OpenapiSpecFile:    *specFilePath,
 		BackupFolder:       *backupFolder,
+		StoreBackend:       *storeBackend,
+		ValueTTL:           *valueTTL,
+		ProjectValueTTLs:   projectTTLs,
+		JanitorInterval:    *janitorInterval,
 	}
 
 	log.Infof("Metadata Broker starting with config: %+v", cfg)
@@ -15,6 +15,13 @@ hasWriteAccess if {
     [admRole, ecmRole, eimRole][_] == role
 }
 
+hasAdminAccess if {
+    admRole := sprintf("%s_ao-rw", [input.metadata.activeprojectid[0]])
+
+    some role in input.metadata["realm_access/roles"] # iteration
+    admRole == role
+}
+
 hasReadAccess if {
     ecmRoleRead := sprintf("%s_cl-r", [input.metadata.activeprojectid[0]])
     eimRoleRead := sprintf("%s_im-r", [input.metadata.activeprojectid[0]])
@@ -40,4 +47,8 @@ GetRequest if {
 
 DeleteProjectRequest if {
     hasWriteAccess
-}
\ No newline at end of file
+}
+
+AdminRequest if {
+    hasAdminAccess
+}
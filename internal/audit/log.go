@@ -0,0 +1,408 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package audit records the changes to the metadata in an append-only log. Each event holds
+// the hash of the previous one, so that altering or removing an event breaks the chain.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Decisions of the authorization policy recorded in the events. The changes made by the
+// service itself are not authorized, they are recorded with DecisionSystem.
+const (
+	DecisionAllowed  = "allowed"
+	DecisionDenied   = "denied"
+	DecisionError    = "error"
+	DecisionDisabled = "disabled"
+	DecisionSystem   = "system"
+)
+
+// Default rotation of the log files.
+const (
+	DefaultMaxSize  = 10 * 1024 * 1024
+	DefaultMaxFiles = 10
+)
+
+// Change is the values of a key before and after a change, along with its display names
+// when the change sets another casing.
+type Change struct {
+	Key           string   `json:"key"`
+	Before        []string `json:"before,omitempty"`
+	After         []string `json:"after,omitempty"`
+	DisplayBefore string   `json:"displayBefore,omitempty"`
+	DisplayAfter  string   `json:"displayAfter,omitempty"`
+}
+
+// Event records a call changing the metadata of a project.
+type Event struct {
+	Sequence  uint64    `json:"sequence"`
+	Time      time.Time `json:"time"`
+	Subject   string    `json:"subject,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	Client    string    `json:"client,omitempty"`
+	ProjectID string    `json:"projectId"`
+	RPC       string    `json:"rpc"`
+	RequestID string    `json:"requestId,omitempty"`
+	Decision  string    `json:"decision"`
+	// Error is why an allowed call failed
+	Error        string   `json:"error,omitempty"`
+	Changes      []Change `json:"changes,omitempty"`
+	PreviousHash string   `json:"previousHash"`
+	Hash         string   `json:"hash"`
+}
+
+// hash returns the SHA-256 of the event without its own hash.
+func (e Event) hash() (string, error) {
+	e.Hash = ""
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Diff returns the changes of the values and of the display names of the keys between before
+// and after, by key.
+func Diff(before, after map[string][]string, beforeDisplay, afterDisplay map[string]string) []Change {
+	var changes []Change
+	for key, values := range before {
+		afterValues, kept := after[key]
+		change := Change{Key: key, Before: values, After: afterValues}
+		recased := kept && beforeDisplay[key] != afterDisplay[key]
+		if recased {
+			change.DisplayBefore, change.DisplayAfter = beforeDisplay[key], afterDisplay[key]
+		}
+		if recased || !slices.Equal(values, afterValues) {
+			changes = append(changes, change)
+		}
+	}
+	for key, values := range after {
+		if _, ok := before[key]; !ok {
+			changes = append(changes, Change{Key: key, After: values})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// Log appends the events to a file, rotated once it reaches its maximum size. The rotated
+// files are named after the file with the sequence of their last event, the oldest are
+// removed beyond the maximum number of files.
+type Log struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	sequence uint64
+	lastHash string
+}
+
+// Open opens the log at path, resuming the chain of its last event. The partial event left at
+// the end of the current file by a crash while appending is removed, invalid events anywhere
+// else fail.
+func Open(path string, maxSize int64, maxFiles int) (*Log, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	if err := truncatePartial(path); err != nil {
+		return nil, err
+	}
+	l := &Log{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	files, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		events, err := readFile(files[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			last := events[len(events)-1]
+			l.sequence, l.lastHash = last.Sequence, last.Hash
+			break
+		}
+	}
+	if err := l.openFile(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// truncatePartial removes the last line of the file if it is not terminated, the events are
+// written whole with their line feed so that only an interrupted write leaves one.
+func truncatePartial(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 || data[len(data)-1] == '\n' {
+		return nil
+	}
+	return os.Truncate(path, int64(bytes.LastIndexByte(data, '\n')+1))
+}
+
+func (l *Log) openFile() error {
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	l.file, l.size = file, info.Size()
+	return nil
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Append chains the event to the previous one and writes it, returning it with its sequence
+// and hashes.
+func (l *Log) Append(e Event) (Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.Sequence = l.sequence + 1
+	e.Time = e.Time.UTC()
+	e.PreviousHash = l.lastHash
+	hash, err := e.hash()
+	if err != nil {
+		return Event{}, err
+	}
+	e.Hash = hash
+	line, err := json.Marshal(e)
+	if err != nil {
+		return Event{}, err
+	}
+	line = append(line, '\n')
+
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return Event{}, err
+		}
+	}
+	if _, err := l.file.Write(line); err != nil {
+		return Event{}, err
+	}
+	if err := l.file.Sync(); err != nil {
+		return Event{}, err
+	}
+	l.size += int64(len(line))
+	l.sequence, l.lastHash = e.Sequence, e.Hash
+	return e, nil
+}
+
+// rotate renames the current file after its last event and starts a new one.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(l.path, fmt.Sprintf("%s.%020d", l.path, l.sequence)); err != nil {
+		return err
+	}
+	rotated, err := filepath.Glob(l.path + ".*")
+	if err != nil {
+		return err
+	}
+	sort.Strings(rotated)
+	for len(rotated) >= l.maxFiles && len(rotated) > 0 {
+		if err := os.Remove(rotated[0]); err != nil {
+			return err
+		}
+		rotated = rotated[1:]
+	}
+	return l.openFile()
+}
+
+// files returns the rotated files, oldest first, then the current file if it exists.
+func (l *Log) files() ([]string, error) {
+	files, err := filepath.Glob(l.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	if _, err := os.Stat(l.path); err == nil {
+		files = append(files, l.path)
+	}
+	return files, nil
+}
+
+func readFile(path string) ([]Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	return readEvents(file, path)
+}
+
+// readEvents reads the events of the file named path from r, one per line.
+func readEvents(r io.Reader, path string) ([]Event, error) {
+	var events []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("invalid audit event in %s: %w", path, err)
+		}
+		events = append(events, e)
+	}
+	return events, scanner.Err()
+}
+
+// events returns all the events of the log, oldest first. The files are opened while the log
+// is locked, up to the size of the current file, and read once it is unlocked: the appends are
+// not held back by the readers, and the files rotated in the meantime are still read whole.
+func (l *Log) events() ([]Event, error) {
+	files, size, err := l.openFiles()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	var events []Event
+	for _, f := range files {
+		var r io.Reader = f
+		if f.Name() == l.path {
+			r = io.LimitReader(f, size)
+		}
+		fileEvents, err := readEvents(r, f.Name())
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, fileEvents...)
+	}
+	return events, nil
+}
+
+// openFiles opens the files of the log, oldest first, returning them with the size of the
+// current file.
+func (l *Log) openFiles() ([]*os.File, int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	paths, err := l.files()
+	if err != nil {
+		return nil, 0, err
+	}
+	files := make([]*os.File, 0, len(paths))
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			for _, f := range files {
+				_ = f.Close()
+			}
+			return nil, 0, err
+		}
+		files = append(files, f)
+	}
+	return files, l.size, nil
+}
+
+// Filter selects the events listed.
+type Filter struct {
+	ProjectID string
+	Key       string
+	Subject   string
+	RPC       string
+	Since     time.Time
+	// Before only lists the events before this sequence, zero lists from the latest event
+	Before uint64
+	Limit  int
+}
+
+func (f Filter) matches(e *Event) bool {
+	if e.ProjectID != f.ProjectID ||
+		(f.Subject != "" && e.Subject != f.Subject) ||
+		(f.RPC != "" && e.RPC != f.RPC) ||
+		(!f.Since.IsZero() && e.Time.Before(f.Since)) ||
+		(f.Before != 0 && e.Sequence >= f.Before) {
+		return false
+	}
+	if f.Key == "" {
+		return true
+	}
+	return slices.ContainsFunc(e.Changes, func(c Change) bool { return c.Key == f.Key })
+}
+
+// List returns the events matching the filter, most recent first, and whether more events
+// match beyond the limit.
+func (l *Log) List(f Filter) ([]Event, bool, error) {
+	events, err := l.events()
+	if err != nil {
+		return nil, false, err
+	}
+	var matched []Event
+	for i := len(events) - 1; i >= 0; i-- {
+		if !f.matches(&events[i]) {
+			continue
+		}
+		if f.Limit > 0 && len(matched) == f.Limit {
+			return matched, true, nil
+		}
+		matched = append(matched, events[i])
+	}
+	return matched, false, nil
+}
+
+// ErrTampered reports an event altered, removed or inserted in the log.
+var ErrTampered = errors.New("the audit log was tampered with")
+
+// Verify checks the hashes of the events and their chain. The chain of the oldest event is
+// not checked, its predecessors may have been rotated out.
+func (l *Log) Verify() error {
+	events, err := l.events()
+	if err != nil {
+		return err
+	}
+	for i, e := range events {
+		hash, err := e.hash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("%w: event %d does not match its hash", ErrTampered, e.Sequence)
+		}
+		if i > 0 && (e.PreviousHash != events[i-1].Hash || e.Sequence != events[i-1].Sequence+1) {
+			return fmt.Errorf("%w: event %d does not follow event %d", ErrTampered, e.Sequence, events[i-1].Sequence)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func event(project, rpc, key string) Event {
+	return Event{
+		Time:      time.Now(),
+		Subject:   "alice",
+		ProjectID: project,
+		RPC:       rpc,
+		Decision:  DecisionAllowed,
+		Changes:   []Change{{Key: key, After: []string{"prod"}}},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := map[string][]string{"env": {"dev"}, "zone": {"eu"}, "team": {"a"}}
+	after := map[string][]string{"env": {"dev", "prod"}, "zone": {"eu"}, "owner": {"bob"}}
+	assert.Equal(t, []Change{
+		{Key: "env", Before: []string{"dev"}, After: []string{"dev", "prod"}},
+		{Key: "owner", After: []string{"bob"}},
+		{Key: "team", Before: []string{"a"}},
+	}, Diff(before, after, nil, nil))
+	assert.Empty(t, Diff(before, before, nil, nil))
+
+	// a new casing of a key is a change, the display names of removed or added keys are not recorded
+	beforeDisplay := map[string]string{"env": "env", "zone": "zone", "team": "team"}
+	afterDisplay := map[string]string{"env": "env", "zone": "Zone", "owner": "Owner"}
+	assert.Equal(t, []Change{
+		{Key: "env", Before: []string{"dev"}, After: []string{"dev", "prod"}},
+		{Key: "owner", After: []string{"bob"}},
+		{Key: "team", Before: []string{"a"}},
+		{Key: "zone", Before: []string{"eu"}, After: []string{"eu"}, DisplayBefore: "zone", DisplayAfter: "Zone"},
+	}, Diff(before, after, beforeDisplay, afterDisplay))
+}
+
+func TestLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	l, err := Open(path, DefaultMaxSize, DefaultMaxFiles)
+	require.NoError(t, err)
+
+	first, err := l.Append(event("p1", "CreateOrUpdateMetadata", "env"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), first.Sequence)
+	assert.Empty(t, first.PreviousHash)
+	assert.NotEmpty(t, first.Hash)
+	second, err := l.Append(event("p1", "DeleteKey", "env"))
+	require.NoError(t, err)
+	assert.Equal(t, first.Hash, second.PreviousHash)
+	assert.NoError(t, l.Verify())
+	require.NoError(t, l.Close())
+
+	// the chain resumes after a restart
+	l, err = Open(path, DefaultMaxSize, DefaultMaxFiles)
+	require.NoError(t, err)
+	third, err := l.Append(event("p2", "DeleteKey", "env"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), third.Sequence)
+	assert.Equal(t, second.Hash, third.PreviousHash)
+	assert.NoError(t, l.Verify())
+	require.NoError(t, l.Close())
+}
+
+func TestLog_tampered(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tamper func(lines []string) []string
+	}{
+		{"altered", func(lines []string) []string {
+			lines[1] = strings.Replace(lines[1], `"subject":"alice"`, `"subject":"bob"`, 1)
+			return lines
+		}},
+		{"removed", func(lines []string) []string {
+			return append(lines[:1], lines[2:]...)
+		}},
+		{"reordered", func(lines []string) []string {
+			lines[0], lines[1] = lines[1], lines[0]
+			return lines
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "audit.log")
+			l, err := Open(path, DefaultMaxSize, DefaultMaxFiles)
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				_, err := l.Append(event("p1", "Delete", "env"))
+				require.NoError(t, err)
+			}
+			raw, err := os.ReadFile(path)
+			require.NoError(t, err)
+			lines := tc.tamper(strings.Split(strings.TrimSpace(string(raw)), "\n"))
+			require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+			assert.ErrorIs(t, l.Verify(), ErrTampered)
+		})
+	}
+}
+
+func TestLog_partialEvent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := Open(path, DefaultMaxSize, DefaultMaxFiles)
+	require.NoError(t, err)
+	first, err := l.Append(event("p1", "Delete", "env"))
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	complete, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// a crash while appending leaves the event without its line feed, it is dropped
+	require.NoError(t, os.WriteFile(path, append(slices.Clone(complete), `{"sequence":2,"ti`...), 0600))
+	l, err = Open(path, DefaultMaxSize, DefaultMaxFiles)
+	require.NoError(t, err)
+	second, err := l.Append(event("p1", "Delete", "env"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), second.Sequence)
+	assert.Equal(t, first.Hash, second.PreviousHash)
+	assert.NoError(t, l.Verify())
+	require.NoError(t, l.Close())
+
+	// an invalid event followed by others is not a partial write
+	require.NoError(t, os.WriteFile(path, append([]byte("{\"sequence\":1,\"ti\n"), complete...), 0600))
+	_, err = Open(path, DefaultMaxSize, DefaultMaxFiles)
+	assert.ErrorContains(t, err, "invalid audit event")
+}
+
+func TestLog_rotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	l, err := Open(path, 1024, 3)
+	require.NoError(t, err)
+	for i := 0; i < 30; i++ {
+		_, err := l.Append(event("p1", "CreateOrUpdateMetadata", fmt.Sprintf("key%d", i)))
+		require.NoError(t, err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "audit.log*"))
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+	for _, f := range files {
+		info, err := os.Stat(f)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1024))
+	}
+	// the oldest events were rotated out, the chain of the remaining ones holds
+	assert.NoError(t, l.Verify())
+	events, _, err := l.List(Filter{ProjectID: "p1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), events[0].Sequence)
+	assert.Less(t, len(events), 30)
+
+	// the chain resumes from the rotated file when the current one is empty
+	require.NoError(t, l.rotate())
+	require.NoError(t, l.Close())
+	l, err = Open(path, 1024, 3)
+	require.NoError(t, err)
+	next, err := l.Append(event("p1", "Delete", "key0"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(31), next.Sequence)
+	require.NoError(t, l.Close())
+}
+
+func TestLog_concurrentReads(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"), 1024, 3)
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 100; i++ {
+			if _, err := l.Append(event("p1", "CreateOrUpdateMetadata", fmt.Sprintf("key%d", i))); err != nil {
+				done <- err
+				return
+			}
+		}
+		close(done)
+	}()
+	// the readers see the chain as of their snapshot, whatever the appends and rotations
+	for running := true; running; {
+		select {
+		case err, running = <-done:
+			require.NoError(t, err)
+		default:
+		}
+		require.NoError(t, l.Verify())
+	}
+	events, _, err := l.List(Filter{ProjectID: "p1", Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), events[0].Sequence)
+	require.NoError(t, l.Close())
+}
+
+func TestLog_List(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"), DefaultMaxSize, DefaultMaxFiles)
+	require.NoError(t, err)
+	start := time.Now()
+	for _, e := range []Event{
+		event("p1", "CreateOrUpdateMetadata", "env"),
+		event("p2", "CreateOrUpdateMetadata", "env"),
+		event("p1", "CreateOrUpdateMetadata", "zone"),
+		{Time: start.Add(time.Minute), Subject: "bob", ProjectID: "p1", RPC: "DeleteKey", Decision: DecisionDenied},
+		event("p1", "DeleteKey", "env"),
+	} {
+		_, err := l.Append(e)
+		require.NoError(t, err)
+	}
+
+	sequences := func(f Filter) ([]uint64, bool) {
+		events, more, err := l.List(f)
+		require.NoError(t, err)
+		var s []uint64
+		for _, e := range events {
+			s = append(s, e.Sequence)
+		}
+		return s, more
+	}
+	testCases := []struct {
+		name     string
+		filter   Filter
+		expected []uint64
+		more     bool
+	}{
+		{"project", Filter{ProjectID: "p1"}, []uint64{5, 4, 3, 1}, false},
+		{"other project", Filter{ProjectID: "p2"}, []uint64{2}, false},
+		{"key", Filter{ProjectID: "p1", Key: "env"}, []uint64{5, 1}, false},
+		{"subject", Filter{ProjectID: "p1", Subject: "bob"}, []uint64{4}, false},
+		{"rpc", Filter{ProjectID: "p1", RPC: "DeleteKey"}, []uint64{5, 4}, false},
+		{"since", Filter{ProjectID: "p1", Since: start.Add(time.Minute)}, []uint64{4}, false},
+		{"limit", Filter{ProjectID: "p1", Limit: 2}, []uint64{5, 4}, true},
+		{"next page", Filter{ProjectID: "p1", Limit: 2, Before: 4}, []uint64{3, 1}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, more := sequences(tc.filter)
+			assert.Equal(t, tc.expected, s)
+			assert.Equal(t, tc.more, more)
+		})
+	}
+}
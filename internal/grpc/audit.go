@@ -0,0 +1,184 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultAuditPageSize is the number of events returned by ListAuditEvents without a page size.
+const DefaultAuditPageSize = 100
+
+// audited authorizes the call changing the project with the OPA rule and runs it, recording
+// it in the audit log with the values of the keys before and after it, of all the keys when
+// keys is nil. The change reads them in the transaction it commits. Denied calls are recorded too.
+func (s *Server) audited(ctx context.Context, rule string, projectId *string, req proto.Message, keys []string, change func(changes *impl.Changes) error) error {
+	authErr := s.authCheckAllowed(ctx, rule, projectId, req)
+	if s.audit == nil {
+		if authErr != nil {
+			return authErr
+		}
+		return change(nil)
+	}
+
+	event := newAuditEvent(ctx, *projectId)
+	switch {
+	case s.opaClient == nil:
+		event.Decision = audit.DecisionDisabled
+	case authErr == nil:
+		event.Decision = audit.DecisionAllowed
+	case errors.IsForbidden(authErr):
+		event.Decision = audit.DecisionDenied
+	default:
+		event.Decision = audit.DecisionError
+	}
+	if authErr != nil {
+		s.record(event)
+		return authErr
+	}
+
+	changes := &impl.Changes{Keys: keys}
+	if err := change(changes); err != nil {
+		event.Error = err.Error()
+		s.record(event)
+		return err
+	}
+	event.Changes = audit.Diff(changes.Before, changes.After, changes.BeforeDisplay, changes.AfterDisplay)
+	s.record(event)
+	return nil
+}
+
+// newAuditEvent returns the event of the call: the caller from the claims of its token, the
+// project, the RPC and the request ID forwarded by the gateway.
+func newAuditEvent(ctx context.Context, projectId string) audit.Event {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	method, _ := grpc.Method(ctx)
+	return audit.Event{
+		Time:      time.Now(),
+		Subject:   first("sub"),
+		Username:  first("preferred_username"),
+		Client:    first("client"),
+		ProjectID: projectId,
+		RPC:       method[strings.LastIndex(method, "/")+1:],
+		RequestID: first("x-request-id"),
+	}
+}
+
+// record appends the event to the audit log. The change is already done, a failure is logged.
+func (s *Server) record(event audit.Event) {
+	if _, err := s.audit.Append(event); err != nil {
+		log.Errorf("Unable to record the audit event of %s in project %s: %v", event.RPC, event.ProjectID, err)
+	}
+}
+
+// ListAuditEvents returns the audit events of the project, most recent first.
+func (s *Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("list audit events for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.AdminRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	if s.audit == nil {
+		return nil, status.Error(codes.Unimplemented, "the audit log is not enabled")
+	}
+
+	filter := audit.Filter{
+		ProjectID: *projectId,
+		Key:       models.Normalize(req.GetKey()),
+		Subject:   req.GetSubject(),
+		RPC:       req.GetRpc(),
+		Limit:     int(req.GetPageSize()),
+	}
+	if req.GetSince() != nil {
+		filter.Since = req.GetSince().AsTime()
+	}
+	if filter.Limit <= 0 || filter.Limit > impl.MaxPageSize {
+		filter.Limit = DefaultAuditPageSize
+	}
+	if token := req.GetPageToken(); token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil {
+			filter.Before, err = strconv.ParseUint(string(decoded), 10, 64)
+		}
+		if err != nil || filter.Before == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", token)
+		}
+	}
+
+	events, more, err := s.audit.List(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read the audit log: %v", err)
+	}
+	resp := &pb.ListAuditEventsResponse{}
+	for _, e := range events {
+		resp.Events = append(resp.Events, auditEventToProto(e))
+	}
+	if more {
+		last := events[len(events)-1].Sequence
+		resp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(last, 10)))
+	}
+	return resp, nil
+}
+
+var auditDecisions = map[string]pb.AuditEvent_Decision{
+	audit.DecisionAllowed:  pb.AuditEvent_DECISION_ALLOWED,
+	audit.DecisionDenied:   pb.AuditEvent_DECISION_DENIED,
+	audit.DecisionError:    pb.AuditEvent_DECISION_ERROR,
+	audit.DecisionDisabled: pb.AuditEvent_DECISION_DISABLED,
+	audit.DecisionSystem:   pb.AuditEvent_DECISION_SYSTEM,
+}
+
+func auditEventToProto(e audit.Event) *pb.AuditEvent {
+	event := &pb.AuditEvent{
+		Sequence:     e.Sequence,
+		Time:         timestamppb.New(e.Time),
+		Subject:      e.Subject,
+		Username:     e.Username,
+		Client:       e.Client,
+		ProjectId:    e.ProjectID,
+		Rpc:          e.RPC,
+		RequestId:    e.RequestID,
+		Decision:     auditDecisions[e.Decision],
+		Error:        e.Error,
+		PreviousHash: e.PreviousHash,
+		Hash:         e.Hash,
+	}
+	for _, c := range e.Changes {
+		event.Changes = append(event.Changes, &pb.AuditChange{
+			Key:           c.Key,
+			Before:        c.Before,
+			After:         c.After,
+			DisplayBefore: c.DisplayBefore,
+			DisplayAfter:  c.DisplayAfter,
+		})
+	}
+	return event
+}
@@ -12,13 +12,37 @@ import (
 	"strings"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/errors"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
 	"google.golang.org/grpc/metadata"
 )
 
-func (s *Server) authCheckAllowed(ctx context.Context, request string) error {
+// requestJSON returns the request as given to the OPA rules, with the proto field names.
+func requestJSON(req proto.Message) (json.RawMessage, error) {
+	if req == nil {
+		return json.RawMessage("{}"), nil
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(req)
+}
+
+// opaInput returns the input of the OPA rules: the request, the metadata of the call, the
+// active project and the name of the RPC, e.g. CreateOrUpdateMetadata.
+func opaInput(ctx context.Context, md metadata.MD, projectId string, request json.RawMessage) map[string]interface{} {
+	method, _ := grpc.Method(ctx)
+	return map[string]interface{}{
+		"request":   request,
+		"metadata":  md,
+		"projectId": projectId,
+		"rpc":       method[strings.LastIndex(method, "/")+1:],
+	}
+}
+
+// authCheckAllowed asks the OPA rule (package.rule) whether the request is allowed in the project.
+// The decisions are cached for the token subject, roles, project, RPC and request of the call.
+func (s *Server) authCheckAllowed(ctx context.Context, request string, projectId *string, req proto.Message) error {
 	if s.opaClient == nil {
 		log.Debugf("ignoring Authorization")
 		return nil
@@ -30,12 +54,43 @@ func (s *Server) authCheckAllowed(ctx context.Context, request string) error {
 	if !ok {
 		return errors.NewInvalid("authentication failed") // errors.NewInvalidArgument(errors.WithMessage("authentication failed"))
 	}
-	opaInputStruct := openpolicyagent.OpaInput{
-		Input: map[string]interface{}{
-			"request":  emptypb.Empty{},
-			"metadata": md,
-		},
+
+	reqJSON, err := requestJSON(req)
+	if err != nil {
+		return errors.NewInternal("unable to convert the request for Open Policy Agent")
 	}
+	rule := request[strings.LastIndex(request, ".")+1:]
+	key, cacheable := newDecisionKey(ctx, md, *projectId, reqJSON)
+	cacheable = cacheable && s.decisions != nil
+	if cacheable {
+		if d, ok := s.decisions.get(key); ok {
+			authzDecisions.WithLabelValues(rule, decisionLabel(d.err), "hit").Inc()
+			return d.err
+		}
+	}
+	err = s.askOpa(ctx, request, opaInput(ctx, md, *projectId, reqJSON))
+	authzDecisions.WithLabelValues(rule, decisionLabel(err), "miss").Inc()
+	// failures to reach the policy are not decisions, the next call asks again
+	if cacheable && (err == nil || errors.IsForbidden(err)) {
+		s.decisions.put(key, err)
+	}
+	return err
+}
+
+// decisionLabel returns the metrics label of the decision.
+func decisionLabel(err error) string {
+	switch {
+	case err == nil:
+		return "allowed"
+	case errors.IsForbidden(err):
+		return "denied"
+	}
+	return "error"
+}
+
+// askOpa evaluates the OPA rule (package.rule) for the input.
+func (s *Server) askOpa(ctx context.Context, request string, input map[string]interface{}) error {
+	opaInputStruct := openpolicyagent.OpaInput{Input: input}
 
 	// can safely ignore the JSON error - will not happen with OPA data
 	completeInputJSON, _ := json.Marshal(opaInputStruct)
@@ -0,0 +1,91 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	concurrentWriters = 20
+	writesPerWriter   = 10
+)
+
+// hammer creates writesPerWriter values for the key from each of concurrentWriters goroutines.
+func (s *MetadataServiceTestSuite) hammer(ctx context.Context, key string) {
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentWriters*writesPerWriter)
+	for w := 0; w < concurrentWriters; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < writesPerWriter; i++ {
+				_, err := s.client.CreateOrUpdateMetadata(ctx, &v1.CreateOrUpdateRequest{
+					Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+						{Key: key, Value: fmt.Sprintf("w%d-v%d", w, i)},
+					}},
+				})
+				if err != nil {
+					errs <- err
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.NoError(err)
+	}
+}
+
+func (s *MetadataServiceTestSuite) expectAllValues(ctx context.Context, key string) {
+	resp, err := s.client.GetMetadata(ctx, &v1.GetMetadataRequest{})
+	s.NoError(err)
+
+	var values []string
+	for _, m := range resp.Metadata {
+		if m.Key == key {
+			values = m.Values
+		}
+	}
+	s.Len(values, concurrentWriters*writesPerWriter)
+	for w := 0; w < concurrentWriters; w++ {
+		for i := 0; i < writesPerWriter; i++ {
+			s.Contains(values, fmt.Sprintf("w%d-v%d", w, i))
+		}
+	}
+}
+
+func (s *MetadataServiceTestSuite) TestConcurrentCreateOrUpdateMetadata() {
+	s.hammer(s.ctx, "concurrent")
+	s.expectAllValues(s.ctx, "concurrent")
+}
+
+func (s *MetadataServiceTestSuite) TestConcurrentCreateOrUpdateMetadataAcrossProjects() {
+	other := metadata.NewOutgoingContext(s.ctx, metadata.Pairs(ActiveProjectID, "otherProject"))
+	defer func() {
+		_, err := s.client.DeleteProject(s.ctx, &v1.DeleteProjectRequest{Id: "otherProject"})
+		s.NoError(err)
+	}()
+
+	var wg sync.WaitGroup
+	for _, ctx := range []context.Context{s.ctx, other} {
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			s.hammer(ctx, "concurrent")
+		}(ctx)
+	}
+	wg.Wait()
+
+	s.expectAllValues(s.ctx, "concurrent")
+	s.expectAllValues(other, "concurrent")
+}
@@ -0,0 +1,129 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxDecisions bounds the number of cached decisions.
+const maxDecisions = 10000
+
+// authzDecisions counts the authorization decisions by rule, result and whether they were cached.
+var authzDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "metadata_broker_authz_decisions_total",
+	Help: "Authorization decisions by OPA rule, decision (allowed, denied or error) and cache (hit or miss).",
+}, []string{"rule", "decision", "cache"})
+
+// decisionKey identifies the caller and the call of a decision. The policy reads the roles
+// and the active project from the metadata, and may read the request, identified by its hash.
+type decisionKey struct {
+	subject string
+	roles   string
+	project string
+	active  string
+	rpc     string
+	request [sha256.Size]byte
+}
+
+// newDecisionKey returns the key of the call with the request given to the policy, false
+// without a token subject to identify the caller.
+func newDecisionKey(ctx context.Context, md metadata.MD, projectId string, request []byte) (decisionKey, bool) {
+	subject := md.Get("sub")
+	if len(subject) == 0 || subject[0] == "" {
+		return decisionKey{}, false
+	}
+	roles := slices.Clone(md.Get("realm_access/roles"))
+	slices.Sort(roles)
+	method, _ := grpc.Method(ctx)
+	return decisionKey{
+		subject: subject[0],
+		roles:   strings.Join(roles, " "),
+		project: projectId,
+		active:  strings.Join(md.Get(strings.ToLower(ActiveProjectID)), " "),
+		rpc:     method,
+		request: sha256.Sum256(request),
+	}, true
+}
+
+type decision struct {
+	// err denies the call, nil allows it
+	err     error
+	expires time.Time
+}
+
+// DecisionCache keeps the authorization decisions for a short TTL, so that bursts of calls
+// with the same token ask the policy once.
+type DecisionCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	decisions map[decisionKey]decision
+}
+
+// NewDecisionCache returns a cache keeping the decisions for ttl.
+func NewDecisionCache(ttl time.Duration) *DecisionCache {
+	return &DecisionCache{
+		ttl:       ttl,
+		now:       time.Now,
+		decisions: make(map[decisionKey]decision),
+	}
+}
+
+// get returns the decision cached for the key, if it has not expired.
+func (c *DecisionCache) get(key decisionKey) (decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d, ok := c.decisions[key]
+	if ok && !c.now().Before(d.expires) {
+		delete(c.decisions, key)
+		return decision{}, false
+	}
+	return d, ok
+}
+
+// put caches the decision for the key, err denying the call.
+func (c *DecisionCache) put(key decisionKey, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if len(c.decisions) >= maxDecisions {
+		for k, d := range c.decisions {
+			if !now.Before(d.expires) {
+				delete(c.decisions, k)
+			}
+		}
+		if len(c.decisions) >= maxDecisions {
+			c.decisions = make(map[decisionKey]decision)
+		}
+	}
+	c.decisions[key] = decision{err: err, expires: now.Add(c.ttl)}
+}
+
+// Invalidate drops the cached decisions, e.g. when the policy is reloaded.
+func (c *DecisionCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.decisions = make(map[decisionKey]decision)
+}
+
+// Len returns the number of cached decisions.
+func (c *DecisionCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.decisions)
+}
@@ -0,0 +1,92 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewDecisionKey(t *testing.T) {
+	ctx := context.Background()
+	request := []byte(`{"key":"env"}`)
+	_, ok := newDecisionKey(ctx, metadata.Pairs(), projectId, request)
+	assert.False(t, ok)
+
+	md := metadata.Pairs("sub", "alice", "realm_access/roles", "p_cl-rw", "realm_access/roles", "p_ao-rw", "activeprojectid", "p")
+	key, ok := newDecisionKey(ctx, md, "p", request)
+	assert.True(t, ok)
+	assert.Equal(t, decisionKey{subject: "alice", roles: "p_ao-rw p_cl-rw", project: "p", active: "p", request: sha256.Sum256(request)}, key)
+
+	// the order of the roles claim does not matter
+	reordered, _ := newDecisionKey(ctx, metadata.Pairs("sub", "alice", "realm_access/roles", "p_ao-rw", "realm_access/roles", "p_cl-rw", "activeprojectid", "p"), "p", request)
+	assert.Equal(t, key, reordered)
+
+	// the policy may decide on the request, another request is another decision
+	other, _ := newDecisionKey(ctx, md, "p", []byte(`{"key":"zone"}`))
+	assert.NotEqual(t, key, other)
+}
+
+func TestDecisionCache(t *testing.T) {
+	now := time.Now()
+	c := NewDecisionCache(time.Second)
+	c.now = func() time.Time { return now }
+	alice := decisionKey{subject: "alice", project: projectId, rpc: "GetMetadata"}
+	bob := decisionKey{subject: "bob", project: projectId, rpc: "GetMetadata"}
+	denied := errors.NewForbidden("denied")
+
+	_, ok := c.get(alice)
+	assert.False(t, ok)
+	c.put(alice, nil)
+	c.put(bob, denied)
+	d, ok := c.get(alice)
+	assert.True(t, ok)
+	assert.NoError(t, d.err)
+	d, ok = c.get(bob)
+	assert.True(t, ok)
+	assert.Equal(t, denied, d.err)
+
+	// expired
+	now = now.Add(time.Second)
+	_, ok = c.get(alice)
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Len())
+
+	c.put(alice, nil)
+	c.Invalidate()
+	_, ok = c.get(alice)
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestDecisionCache_bounded(t *testing.T) {
+	now := time.Now()
+	c := NewDecisionCache(time.Second)
+	c.now = func() time.Time { return now }
+	for i := 0; i < maxDecisions; i++ {
+		c.put(decisionKey{subject: fmt.Sprint(i)}, nil)
+	}
+	assert.Equal(t, maxDecisions, c.Len())
+
+	// the expired decisions make room
+	now = now.Add(time.Second)
+	c.put(decisionKey{subject: "alice"}, nil)
+	assert.Equal(t, 1, c.Len())
+
+	// without expired decisions the cache starts over
+	for i := 1; i < maxDecisions; i++ {
+		c.put(decisionKey{subject: fmt.Sprint(i)}, nil)
+	}
+	c.put(decisionKey{subject: "bob"}, nil)
+	assert.Equal(t, 1, c.Len())
+}
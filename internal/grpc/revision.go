@@ -0,0 +1,60 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// IfMatch is the metadata holding the revision a write expects, as sent in the If-Match header.
+	IfMatch = "if-match"
+	// ETag is the header metadata returning the revision of the project.
+	ETag = "etag"
+)
+
+// expectedRevision returns the revision the write expects the project to be at, nil when unchecked.
+// A revision set in the request, zero included, wins over the If-Match metadata.
+func expectedRevision(ctx context.Context, requested *uint64) (*uint64, error) {
+	if requested != nil {
+		return requested, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(IfMatch)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	ifMatch := strings.TrimSpace(values[0])
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	// revisions are strong validators, a weak one is accepted as well
+	tag := strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+	revision, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid If-Match %q: a single revision is expected", ifMatch)
+	}
+	return &revision, nil
+}
+
+// setETag returns the revision of the project as the ETag header.
+func setETag(ctx context.Context, revision uint64) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(ETag, fmt.Sprintf(`"%d"`, revision))); err != nil {
+		log.Warnf("Unable to set the ETag header: %v", err)
+	}
+}
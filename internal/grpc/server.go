@@ -11,6 +11,7 @@ import (
 	"github.com/atomix/dazl"
 	"github.com/open-edge-platform/orch-library/go/pkg/northbound"
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
 	"google.golang.org/grpc"
@@ -23,24 +24,32 @@ import (
 var log = dazl.GetPackageLogger()
 
 // NewService returns a new metadata service
-func NewService(opaClient openpolicyagent.ClientWithResponsesInterface) northbound.Service {
+func NewService(opaClient openpolicyagent.ClientWithResponsesInterface, decisions *DecisionCache, auditLog *audit.Log) northbound.Service {
 	return &Service{
 		OpaClient: opaClient,
+		Decisions: decisions,
+		Audit:     auditLog,
 	}
 }
 
 // Service is metadata service.
 type Service struct {
 	OpaClient openpolicyagent.ClientWithResponsesInterface
+	// Decisions caches the authorization decisions, nil asks the policy on every call
+	Decisions *DecisionCache
+	// Audit records the changes to the metadata, nil disables the audit
+	Audit *audit.Log
 }
 
 // Register registers the Service with the gRPC server.
 func (s Service) Register(r *grpc.Server) {
-	pb.RegisterMetadataServiceServer(r, &Server{opaClient: s.OpaClient})
+	pb.RegisterMetadataServiceServer(r, &Server{opaClient: s.OpaClient, decisions: s.Decisions, audit: s.Audit})
 }
 
 type Server struct {
 	opaClient openpolicyagent.ClientWithResponsesInterface
+	decisions *DecisionCache
+	audit     *audit.Log
 }
 
 const ActiveProjectID = "ActiveProjectID"
@@ -67,72 +76,334 @@ func (s *Server) CreateOrUpdateMetadata(ctx context.Context, request *pb.CreateO
 	if err != nil {
 		return nil, err
 	}
-	if err := s.authCheckAllowed(ctx, "metadatav1.CreateOrUpdateRequest"); err != nil {
+	var keys []string
+	for _, md := range request.GetBody().GetMetadata() {
+		keys = append(keys, md.GetKey())
+	}
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.CreateOrUpdateRequest", projectId, request, keys, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, request.ExpectedRevision)
+		if err != nil {
+			return err
+		}
+		withSource(ctx, request.GetBody().GetMetadata())
+		resp, err = impl.CreateOrUpdateList(projectId, request.GetBody().GetMetadata(), expected, changes)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
 
-	for _, m := range request.Body.Metadata {
-		if _, err := impl.CreateOrUpdate(projectId, m); err != nil {
-			return nil, err
+// Delete removes the specified metadata.
+func (s *Server) Delete(ctx context.Context, req *pb.Metadata) (*pb.MetadataResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("delete metadata for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.DeleteRequest", projectId, req, []string{req.GetKey()}, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, nil)
+		if err != nil {
+			return err
 		}
+		resp, err = impl.Delete(projectId, req, expected, changes)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
 
-	stored, err := impl.GetSystemMetadata(projectId)
+// DeleteKey removes the specified key with all its values.
+func (s *Server) DeleteKey(ctx context.Context, req *pb.DeleteKeyRequest) (*pb.MetadataResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("delete key for project %s: %+v", projectId, req)
 	if err != nil {
 		return nil, err
 	}
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.DeleteRequest", projectId, req, []string{req.GetKey()}, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = impl.DeleteKey(projectId, req.GetKey(), expected, changes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
 
-	return &pb.MetadataResponse{Metadata: stored}, nil
+// BatchDelete removes the specified metadata all-or-nothing.
+func (s *Server) BatchDelete(ctx context.Context, req *pb.MetadataList) (*pb.MetadataResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("batch delete metadata for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, md := range req.GetMetadata() {
+		keys = append(keys, md.GetKey())
+	}
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.DeleteRequest", projectId, req, keys, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = impl.BatchDelete(projectId, req.GetMetadata(), expected, changes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
 }
 
-// Delete removes the specified metadata.
-func (s *Server) Delete(ctx context.Context, req *pb.Metadata) (*pb.MetadataResponse, error) {
+// RenameKey moves all the values of a key to another key.
+func (s *Server) RenameKey(ctx context.Context, req *pb.RenameKeyRequest) (*pb.MetadataResponse, error) {
 	projectId, err := GetActiveProjectID(ctx)
-	log.Debugf("delete metadata for project %s: %+v", projectId, req)
+	log.Infof("rename key for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.AdminRequest", projectId, req, []string{req.GetKey(), req.GetNewKey()}, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = impl.RenameKey(projectId, req.GetKey(), req.GetNewKey(), expected, changes)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if err := s.authCheckAllowed(ctx, "metadatav1.DeleteRequest"); err != nil {
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
+
+// MergeValues merges values of a key into another value.
+func (s *Server) MergeValues(ctx context.Context, req *pb.MergeValuesRequest) (*pb.MetadataResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Infof("merge values for project %s: %+v", projectId, req)
+	if err != nil {
 		return nil, err
 	}
-	res, err := impl.Delete(projectId, req)
+	var resp *pb.MetadataResponse
+	err = s.audited(ctx, "metadatav1.AdminRequest", projectId, req, []string{req.GetKey()}, func(changes *impl.Changes) error {
+		expected, err := expectedRevision(ctx, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = impl.MergeValues(projectId, req.GetKey(), req.GetValues(), req.GetInto(), expected, changes)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &pb.MetadataResponse{Metadata: res}, nil
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
+
+// SetKeySchema registers the schema of a key.
+func (s *Server) SetKeySchema(ctx context.Context, req *pb.KeySchema) (*pb.KeySchema, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Infof("set key schema for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.AdminRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	return impl.SetKeySchema(projectId, req)
+}
+
+// DeleteKeySchema removes the schema of a key.
+func (s *Server) DeleteKeySchema(ctx context.Context, req *pb.DeleteKeySchemaRequest) (*emptypb.Empty, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Infof("delete key schema for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.AdminRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	if err := impl.DeleteKeySchema(projectId, req.GetKey()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListKeySchemas retrieves the schemas of the keys.
+func (s *Server) ListKeySchemas(ctx context.Context, req *pb.ListKeySchemasRequest) (*pb.ListKeySchemasResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("listing key schemas for project %s", projectId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	return impl.ListKeySchemas(projectId)
+}
+
+// GetValidationProfile retrieves the validation profile of the project.
+func (s *Server) GetValidationProfile(ctx context.Context, req *pb.GetValidationProfileRequest) (*pb.ValidationProfileResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("getting validation profile for project %s", projectId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	return impl.GetValidationProfile(projectId)
+}
+
+// SetValidationProfile selects the validation profile of the project.
+func (s *Server) SetValidationProfile(ctx context.Context, req *pb.SetValidationProfileRequest) (*pb.ValidationProfileResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Infof("set validation profile for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.AdminRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	return impl.SetValidationProfile(projectId, req.GetProfile())
 }
 
 // GetMetadata retrieves the current set of metadata.
-func (s *Server) GetMetadata(ctx context.Context, empty *emptypb.Empty) (*pb.MetadataResponse, error) {
+func (s *Server) GetMetadata(ctx context.Context, req *pb.GetMetadataRequest) (*pb.MetadataResponse, error) {
 	projectId, err := GetActiveProjectID(ctx)
 	log.Debugf("getting metadata for project %s", projectId)
 	if err != nil {
 		return nil, err
 	}
-	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest"); err != nil {
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
 		return nil, err
 	}
-	stored, err := impl.GetSystemMetadata(projectId)
+	resp, err := impl.GetMetadata(projectId, req)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.MetadataResponse{Metadata: stored}, nil
+	setETag(ctx, resp.Revision)
+	return resp, nil
 }
 
-func (s *Server) DeleteProject(ctx context.Context, request *pb.DeleteProjectRequest) (*emptypb.Empty, error) {
-	log.Debugf("deleting project %s", request)
+// GetKey retrieves the values of a single key.
+func (s *Server) GetKey(ctx context.Context, req *pb.GetKeyRequest) (*pb.GetKeyResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("getting key %s for project %s", req.GetKey(), projectId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	resp, err := impl.GetKey(projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
 
-	if err := s.authCheckAllowed(ctx, "metadatav1.DeleteProjectRequest"); err != nil {
+// ListKeys retrieves the keys with their number of values.
+func (s *Server) ListKeys(ctx context.Context, req *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("listing keys for project %s", projectId)
+	if err != nil {
 		return nil, err
 	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	resp, err := impl.ListKeys(projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	setETag(ctx, resp.Revision)
+	return resp, nil
+}
 
-	projectId := request.GetId()
+func (s *Server) DeleteProject(ctx context.Context, request *pb.DeleteProjectRequest) (*emptypb.Empty, error) {
+	log.Debugf("deleting project %s", request)
 
-	err := impl.DeleteProject(&projectId)
+	projectId := request.GetId()
 
+	err := s.audited(ctx, "metadatav1.DeleteProjectRequest", &projectId, request, nil, func(changes *impl.Changes) error {
+		return impl.DeleteProject(&projectId, changes)
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// SearchMetadata returns the metadata of the active project matching a partial key or value.
+func (s *Server) SearchMetadata(ctx context.Context, req *pb.SearchMetadataRequest) (*pb.SearchMetadataResponse, error) {
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("searching metadata for project %s: %+v", projectId, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, req); err != nil {
+		return nil, err
+	}
+	return impl.Search(projectId, req)
+}
+
+// WatchMetadata streams the changes of the metadata of the active project until the client goes away.
+func (s *Server) WatchMetadata(request *pb.WatchMetadataRequest, stream pb.MetadataService_WatchMetadataServer) error {
+	ctx := stream.Context()
+	projectId, err := GetActiveProjectID(ctx)
+	log.Debugf("watching metadata for project %s: %+v", projectId, request)
+	if err != nil {
+		return err
+	}
+	if err := s.authCheckAllowed(ctx, "metadatav1.GetRequest", projectId, request); err != nil {
+		return err
+	}
+
+	sub, responses, err := impl.Watch(projectId, request.GetSnapshot(), request.GetResumeRevision())
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	// let the client know that the watch is established, even if there is nothing to send yet
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for _, resp := range responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case resp, ok := <-sub.C():
+			if !ok {
+				return sub.Err()
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -7,23 +7,31 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/policy"
 	v1 "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/suite"
 	gomock "go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -122,18 +130,25 @@ func newTestService(opaClient openpolicyagent.ClientWithResponsesInterface) (Ser
 	return Service{OpaClient: opaClient}, nil
 }
 
-func createServerConnection(t *testing.T, opaClient openpolicyagent.ClientWithResponsesInterface) *grpc.ClientConn {
-	lis = bufconn.Listen(1024 * 1024)
+func createServerConnection(t *testing.T, opaClient openpolicyagent.ClientWithResponsesInterface, opts ...grpc.ServerOption) *grpc.ClientConn {
 	s, err := newTestService(opaClient)
 	assert.NoError(t, err)
 	assert.NotNil(t, s)
+	return serveService(t, s, opts...)
+}
+
+// serveService serves the service on a new in-memory listener and returns a connection to it.
+func serveService(t *testing.T, s Service, opts ...grpc.ServerOption) *grpc.ClientConn {
+	// the server serves its own listener, lis is replaced by the next connection
+	l := bufconn.Listen(1024 * 1024)
+	lis = l
 	// Test server using in-memory bufconn, no network communication, TLS not needed
 	// nosemgrep: go.grpc.security.grpc-server-insecure-connection.grpc-server-insecure-connection
-	server := grpc.NewServer()
+	server := grpc.NewServer(opts...)
 	s.Register(server)
 
 	go func() {
-		if err := server.Serve(lis); err != nil {
+		if err := server.Serve(l); err != nil {
 			assert.NoError(t, err, "Server exited with error: %v", err)
 		}
 	}()
@@ -146,7 +161,7 @@ func createServerConnection(t *testing.T, opaClient openpolicyagent.ClientWithRe
 }
 
 func TestNewService(t *testing.T) {
-	s := NewService(nil)
+	s := NewService(nil, nil, nil)
 	assert.NotNil(t, s)
 }
 
@@ -184,17 +199,517 @@ func (s *MetadataServiceTestSuite) TestCreateOrUpdateMetadata() {
 	// })
 }
 
+func (s *MetadataServiceTestSuite) TestCreateOrUpdateMetadataBatch() {
+	resp, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+			{Key: "k1", Value: "v1"},
+		},
+		}})
+	s.NoError(err)
+	s.Len(resp.Created, 1)
+	s.Empty(resp.Existing)
+
+	resp, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+			{Key: "k1", Value: "v1"},
+			{Key: "k1", Value: "v2"},
+			{Key: "k2", Value: "v1"},
+		},
+		}})
+	s.NoError(err)
+	s.Equal([]string{"k1=v2", "k2=v1"}, pairs(resp.Created))
+	s.Equal([]string{"k1=v1"}, pairs(resp.Existing))
+	s.validateMetadata(resp.Metadata, map[string][]string{
+		"k1": {"v1", "v2"},
+		"k2": {"v1"},
+	})
+}
+
+func (s *MetadataServiceTestSuite) TestRevision() {
+	var header metadata.MD
+	// an expected revision of zero creates the metadata of a project never written
+	resp, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body:             &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "k1", Value: "v1"}}},
+		ExpectedRevision: proto.Uint64(0),
+	}, grpc.Header(&header))
+	s.NoError(err)
+	s.Equal(uint64(1), resp.Revision)
+	s.Equal([]string{`"1"`}, header.Get(ETag))
+
+	// a stale expected revision is rejected, from the request or from If-Match
+	for _, expected := range []uint64{0, 2} {
+		_, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+			Body:             &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "k1", Value: "v2"}}},
+			ExpectedRevision: proto.Uint64(expected),
+		})
+		s.Equal(codes.FailedPrecondition, status.Code(err))
+	}
+	ctx := metadata.AppendToOutgoingContext(s.ctx, IfMatch, `"0"`)
+	_, err = s.client.Delete(ctx, &v1.Metadata{Key: "k1", Value: "v1"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+	ctx = metadata.AppendToOutgoingContext(s.ctx, IfMatch, "not-a-revision")
+	_, err = s.client.Delete(ctx, &v1.Metadata{Key: "k1", Value: "v1"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(s.ctx, IfMatch, `W/"1"`)
+	resp, err = s.client.Delete(ctx, &v1.Metadata{Key: "k1", Value: "v1"}, grpc.Header(&header))
+	s.NoError(err)
+	s.Equal(uint64(2), resp.Revision)
+	s.Equal([]string{`"2"`}, header.Get(ETag))
+
+	resp, err = s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{}, grpc.Header(&header))
+	s.NoError(err)
+	s.Equal(uint64(2), resp.Revision)
+	s.Equal([]string{`"2"`}, header.Get(ETag))
+
+	// the revision keeps increasing once the project is deleted and created again
+	_, err = s.client.DeleteProject(s.ctx, &v1.DeleteProjectRequest{Id: projectId})
+	s.NoError(err)
+	resp, err = s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{}, grpc.Header(&header))
+	s.NoError(err)
+	s.Equal(uint64(3), resp.Revision)
+	s.Equal([]string{`"3"`}, header.Get(ETag))
+	for _, stale := range []string{`"0"`, `"1"`} {
+		ctx = metadata.AppendToOutgoingContext(s.ctx, IfMatch, stale)
+		_, err = s.client.CreateOrUpdateMetadata(ctx, &v1.CreateOrUpdateRequest{
+			Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "k1", Value: "v1"}}},
+		})
+		s.Equal(codes.FailedPrecondition, status.Code(err))
+	}
+	resp, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body:             &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "k1", Value: "v1"}}},
+		ExpectedRevision: proto.Uint64(3),
+	})
+	s.NoError(err)
+	s.Equal(uint64(4), resp.Revision)
+}
+
+func (s *MetadataServiceTestSuite) TestGetMetadataBySource() {
+	ctx := metadata.AppendToOutgoingContext(s.ctx, Client, "app-orch/1.0")
+	resp, err := s.client.CreateOrUpdateMetadata(ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+			{Key: "customer", Value: "culvers"},
+			{Key: "region", Value: "west", Source: "cluster-orch"},
+		}},
+	})
+	s.NoError(err)
+	s.Equal("app-orch", resp.Created[0].Source)
+	s.Equal("cluster-orch", resp.Created[1].Source)
+	_, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "customer", Value: "culvers", Source: "edge-infra"}}},
+	})
+	s.NoError(err)
+	// explicit sources are validated by the server
+	for _, source := range []string{"edge infra", "-edge-infra", "edge/infra", strings.Repeat("a", 64)} {
+		_, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+			Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "region", Value: "east", Source: source}}},
+		})
+		s.Equal(codes.InvalidArgument, status.Code(err), source)
+	}
+
+	tests := map[string]map[string][]string{
+		"":             {"customer": {"culvers"}, "region": {"west"}},
+		"app-orch":     {"customer": {"culvers"}},
+		"edge-infra":   {"customer": {"culvers"}},
+		"cluster-orch": {"region": {"west"}},
+		"unknown":      {},
+	}
+	for source, want := range tests {
+		resp, err := s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{Source: source})
+		s.NoError(err)
+		s.validateMetadata(resp.Metadata, want)
+	}
+}
+
+func (s *MetadataServiceTestSuite) TestSearchMetadata() {
+	_, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+			{Key: "customer", Value: "culvers"},
+			{Key: "customer", Value: "kulvers"},
+			{Key: "color", Value: "blue", Source: "app-orch"},
+		}},
+	})
+	s.NoError(err)
+
+	resp, err := s.client.SearchMetadata(s.ctx, &v1.SearchMetadataRequest{Query: "CULV"})
+	s.NoError(err)
+	s.Equal([]string{"customer=culvers", "customer=kulvers"}, searchPairs(resp.Matches))
+	s.Equal(v1.SearchMatch_MATCH_TYPE_PREFIX, resp.Matches[0].Type)
+	s.Equal(v1.SearchMatch_MATCH_TYPE_FUZZY, resp.Matches[1].Type)
+
+	resp, err = s.client.SearchMetadata(s.ctx, &v1.SearchMetadataRequest{Query: "c", Limit: 2})
+	s.NoError(err)
+	s.Equal([]string{"color=", "customer=culvers"}, searchPairs(resp.Matches))
+
+	resp, err = s.client.SearchMetadata(s.ctx, &v1.SearchMetadataRequest{Query: "c", Source: "app-orch"})
+	s.NoError(err)
+	s.Equal([]string{"color="}, searchPairs(resp.Matches))
+
+	_, err = s.client.SearchMetadata(s.ctx, &v1.SearchMetadataRequest{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *MetadataServiceTestSuite) TestGetKeyAndListKeys() {
+	_, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+			{Key: "customer", Value: "culvers"},
+			{Key: "customer", Value: "acme"},
+			{Key: "color", Value: "blue", Source: "app-orch"},
+		}},
+	})
+	s.NoError(err)
+
+	key, err := s.client.GetKey(s.ctx, &v1.GetKeyRequest{Key: "Customer", OrderBy: v1.GetMetadataRequest_ORDER_BY_ALPHABETICAL})
+	s.NoError(err)
+	s.Equal("customer", key.Metadata.Key)
+	s.Equal([]string{"acme", "culvers"}, key.Metadata.Values)
+
+	_, err = s.client.GetKey(s.ctx, &v1.GetKeyRequest{Key: "missing"})
+	s.Equal(codes.NotFound, status.Code(err))
+	_, err = s.client.GetKey(s.ctx, &v1.GetKeyRequest{Key: "customer", Source: "app-orch"})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	keys, err := s.client.ListKeys(s.ctx, &v1.ListKeysRequest{})
+	s.NoError(err)
+	s.Equal(key.Revision, keys.Revision)
+	s.Equal([]string{"customer=2", "color=1"}, keyCounts(keys.Keys))
+
+	keys, err = s.client.ListKeys(s.ctx, &v1.ListKeysRequest{Source: "app-orch"})
+	s.NoError(err)
+	s.Equal([]string{"color=1"}, keyCounts(keys.Keys))
+}
+
+func keyCounts(keys []*v1.KeySummary) []string {
+	var p []string
+	for _, k := range keys {
+		p = append(p, fmt.Sprintf("%s=%d", k.Key, k.ValueCount))
+	}
+	return p
+}
+
+func (s *MetadataServiceTestSuite) TestKeySchemas() {
+	schema, err := s.client.SetKeySchema(s.ctx, &v1.KeySchema{
+		Key: "environment", Type: v1.KeySchema_TYPE_ENUM, AllowedValues: []string{"dev", "staging", "prod"},
+	})
+	s.NoError(err)
+	s.Equal([]string{"dev", "staging", "prod"}, schema.AllowedValues)
+
+	s.create("environment", "prod")
+	_, err = s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "color", Value: "red"}, {Key: "environment", Value: "qa"}}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.Contains(status.Convert(err).Message(), "allowed values are dev, staging, prod")
+	// nothing is stored when an entry is rejected
+	resp, err := s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{})
+	s.NoError(err)
+	s.validateMetadata(resp.Metadata, map[string][]string{"environment": {"prod"}})
+
+	_, err = s.client.SetKeySchema(s.ctx, &v1.KeySchema{Key: "environment", Type: v1.KeySchema_TYPE_REGEX, Pattern: "dev"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	schemas, err := s.client.ListKeySchemas(s.ctx, &v1.ListKeySchemasRequest{})
+	s.NoError(err)
+	s.Len(schemas.Schemas, 1)
+	s.Equal(v1.KeySchema_TYPE_ENUM, schemas.Schemas[0].Type)
+
+	_, err = s.client.DeleteKeySchema(s.ctx, &v1.DeleteKeySchemaRequest{Key: "environment"})
+	s.NoError(err)
+	s.create("environment", "qa")
+}
+
+func (s *MetadataServiceTestSuite) TestDisplayCasing() {
+	resp, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "Customer", Value: "ACME-Corp"}}},
+	})
+	s.NoError(err)
+	s.Len(resp.Metadata, 1)
+	s.Equal("customer", resp.Metadata[0].Key)
+	s.Equal("Customer", resp.Metadata[0].DisplayKey)
+	s.Equal([]string{"acme-corp"}, resp.Metadata[0].Values)
+	s.Equal(map[string]string{"acme-corp": "ACME-Corp"}, resp.Metadata[0].DisplayValues)
+
+	// later writes match whatever their casing and keep the first display form
+	s.create("CUSTOMER", "acme-corp")
+	key, err := s.client.GetKey(s.ctx, &v1.GetKeyRequest{Key: "CusTomer"})
+	s.NoError(err)
+	s.Equal("Customer", key.Metadata.DisplayKey)
+	s.Equal(map[string]string{"acme-corp": "ACME-Corp"}, key.Metadata.DisplayValues)
+
+	keys, err := s.client.ListKeys(s.ctx, &v1.ListKeysRequest{})
+	s.NoError(err)
+	s.Len(keys.Keys, 1)
+	s.Equal("Customer", keys.Keys[0].DisplayKey)
+
+	_, err = s.client.Delete(s.ctx, &v1.Metadata{Key: "customer", Value: "Acme-Corp"})
+	s.NoError(err)
+}
+
+func (s *MetadataServiceTestSuite) TestValidationProfiles() {
+	create := func(key, value string) error {
+		_, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+			Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: key, Value: value}}},
+		})
+		return err
+	}
+	profile, err := s.client.GetValidationProfile(s.ctx, &v1.GetValidationProfileRequest{})
+	s.NoError(err)
+	s.Equal(v1.ValidationProfile_VALIDATION_PROFILE_K8S_LABEL, profile.Profile)
+	s.Equal(codes.InvalidArgument, status.Code(create("customer", "Société Générale")))
+
+	// the profile of a key
+	_, err = s.client.SetKeySchema(s.ctx, &v1.KeySchema{Key: "customer", Profile: v1.ValidationProfile_VALIDATION_PROFILE_EXTENDED})
+	s.NoError(err)
+	s.NoError(create("customer", "Société Générale"))
+	s.Equal(codes.InvalidArgument, status.Code(create("site", "Paris 1")))
+
+	// the profile of the project
+	profile, err = s.client.SetValidationProfile(s.ctx, &v1.SetValidationProfileRequest{Profile: v1.ValidationProfile_VALIDATION_PROFILE_EXTENDED})
+	s.NoError(err)
+	s.Equal(v1.ValidationProfile_VALIDATION_PROFILE_EXTENDED, profile.Profile)
+	s.NoError(create("site", "Paris 1"))
+	_, err = s.client.SetValidationProfile(s.ctx, &v1.SetValidationProfileRequest{})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *MetadataServiceTestSuite) TestOpaInput() {
+	var inputs []map[string]interface{}
+	opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(s.T()))
+	result := openpolicyagent.OpaResponse_Result{}
+	s.NoError(result.FromOpaResponseResult1(true))
+	opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(gomock.Any(), "metadatav1", gomock.Any(), gomock.Any(), "application/json", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, _ *openpolicyagent.PostV1DataPackageRuleParams, _ string, body io.Reader, _ ...openpolicyagent.RequestEditorFn) (*openpolicyagent.PostV1DataPackageRuleResponse, error) {
+			var input openpolicyagent.OpaInput
+			s.NoError(json.NewDecoder(body).Decode(&input))
+			inputs = append(inputs, input.Input)
+			return &openpolicyagent.PostV1DataPackageRuleResponse{JSON200: &openpolicyagent.OpaResponse{Result: result}}, nil
+		},
+	).AnyTimes()
+	s.client = v1.NewMetadataServiceClient(createServerConnection(s.T(), opaMock))
+
+	s.create("Environment", "prod")
+	_, err := s.client.GetKey(s.ctx, &v1.GetKeyRequest{Key: "environment"})
+	s.NoError(err)
+
+	s.Len(inputs, 2)
+	s.Equal("CreateOrUpdateMetadata", inputs[0]["rpc"])
+	s.Equal(projectId, inputs[0]["projectId"])
+	s.Equal(map[string]interface{}{
+		"body": map[string]interface{}{"metadata": []interface{}{map[string]interface{}{"key": "Environment", "value": "prod"}}},
+	}, inputs[0]["request"])
+	s.Equal([]interface{}{projectId}, inputs[0]["metadata"].(map[string]interface{})["activeprojectid"])
+	s.Equal("GetKey", inputs[1]["rpc"])
+	s.Equal(map[string]interface{}{"key": "environment"}, inputs[1]["request"])
+}
+
+// rolesInterceptor sets the roles claim from the "roles" header, as the JWT interceptor does from the token.
+func rolesInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set("realm_access/roles", md.Get("roles")...)
+	return handler(metadata.NewIncomingContext(ctx, md), req)
+}
+
+func (s *MetadataServiceTestSuite) TestEmbeddedPolicy() {
+	engine, err := policy.NewEngine("../../deployments/orch-metadata-broker/files/openpolicyagent/policies.rego")
+	s.Require().NoError(err)
+	s.client = v1.NewMetadataServiceClient(createServerConnection(s.T(), engine, grpc.UnaryInterceptor(rolesInterceptor)))
+	create := &v1.CreateOrUpdateRequest{Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: "environment", Value: "prod"}}}}
+
+	reader := metadata.AppendToOutgoingContext(s.ctx, "roles", projectId+"_cl-r")
+	_, err = s.client.GetMetadata(reader, &v1.GetMetadataRequest{})
+	s.NoError(err)
+	_, err = s.client.CreateOrUpdateMetadata(reader, create)
+	s.ErrorContains(err, "access denied by OPA rule CreateOrUpdateRequest")
+
+	writer := metadata.AppendToOutgoingContext(s.ctx, "roles", projectId+"_cl-rw")
+	_, err = s.client.CreateOrUpdateMetadata(writer, create)
+	s.NoError(err)
+	_, err = s.client.SetValidationProfile(writer, &v1.SetValidationProfileRequest{Profile: v1.ValidationProfile_VALIDATION_PROFILE_EXTENDED})
+	s.ErrorContains(err, "access denied by OPA rule AdminRequest")
+
+	admin := metadata.AppendToOutgoingContext(s.ctx, "roles", projectId+"_ao-rw")
+	_, err = s.client.SetValidationProfile(admin, &v1.SetValidationProfileRequest{Profile: v1.ValidationProfile_VALIDATION_PROFILE_EXTENDED})
+	s.NoError(err)
+}
+
+func (s *MetadataServiceTestSuite) TestDecisionCache() {
+	calls := 0
+	allowed := true
+	opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(s.T()))
+	opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(gomock.Any(), "metadatav1", gomock.Any(), gomock.Any(), "application/json", gomock.Any()).DoAndReturn(
+		func(context.Context, string, string, *openpolicyagent.PostV1DataPackageRuleParams, string, io.Reader, ...openpolicyagent.RequestEditorFn) (*openpolicyagent.PostV1DataPackageRuleResponse, error) {
+			calls++
+			result := openpolicyagent.OpaResponse_Result{}
+			s.NoError(result.FromOpaResponseResult1(allowed))
+			return &openpolicyagent.PostV1DataPackageRuleResponse{JSON200: &openpolicyagent.OpaResponse{Result: result}}, nil
+		},
+	).AnyTimes()
+	decisions := NewDecisionCache(time.Minute)
+	s.client = v1.NewMetadataServiceClient(serveService(s.T(), Service{OpaClient: opaMock, Decisions: decisions}))
+	hits := testutil.ToFloat64(authzDecisions.WithLabelValues("GetRequest", "allowed", "hit"))
+
+	alice := metadata.AppendToOutgoingContext(s.ctx, "sub", "alice")
+	for i := 0; i < 3; i++ {
+		_, err := s.client.GetMetadata(alice, &v1.GetMetadataRequest{})
+		s.NoError(err)
+	}
+	s.Equal(1, calls)
+	s.Equal(hits+2, testutil.ToFloat64(authzDecisions.WithLabelValues("GetRequest", "allowed", "hit")))
+
+	// another caller, RPC, project or request asks the policy
+	_, err := s.client.GetMetadata(metadata.AppendToOutgoingContext(s.ctx, "sub", "bob"), &v1.GetMetadataRequest{})
+	s.NoError(err)
+	_, err = s.client.ListKeys(alice, &v1.ListKeysRequest{})
+	s.NoError(err)
+	other := metadata.AppendToOutgoingContext(metadata.NewOutgoingContext(s.ctx, metadata.Pairs(ActiveProjectID, "other")), "sub", "alice")
+	_, err = s.client.GetMetadata(other, &v1.GetMetadataRequest{})
+	s.NoError(err)
+	_, err = s.client.GetMetadata(alice, &v1.GetMetadataRequest{Keys: []string{"env"}})
+	s.NoError(err)
+	s.Equal(5, calls)
+
+	// denials are cached too, until invalidated
+	allowed = false
+	decisions.Invalidate()
+	_, err = s.client.GetMetadata(alice, &v1.GetMetadataRequest{})
+	s.ErrorContains(err, "access denied by OPA rule GetRequest")
+	_, err = s.client.GetMetadata(alice, &v1.GetMetadataRequest{})
+	s.ErrorContains(err, "access denied by OPA rule GetRequest")
+	s.Equal(6, calls)
+
+	// without a token subject the decisions are not cached
+	allowed = true
+	for i := 0; i < 2; i++ {
+		_, err = s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{})
+		s.NoError(err)
+	}
+	s.Equal(8, calls)
+}
+
+func (s *MetadataServiceTestSuite) TestAuditEvents() {
+	allowed := true
+	opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(s.T()))
+	opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(gomock.Any(), "metadatav1", gomock.Any(), gomock.Any(), "application/json", gomock.Any()).DoAndReturn(
+		func(context.Context, string, string, *openpolicyagent.PostV1DataPackageRuleParams, string, io.Reader, ...openpolicyagent.RequestEditorFn) (*openpolicyagent.PostV1DataPackageRuleResponse, error) {
+			result := openpolicyagent.OpaResponse_Result{}
+			s.NoError(result.FromOpaResponseResult1(allowed))
+			return &openpolicyagent.PostV1DataPackageRuleResponse{JSON200: &openpolicyagent.OpaResponse{Result: result}}, nil
+		},
+	).AnyTimes()
+	auditLog, err := audit.Open(path.Join(s.T().TempDir(), "audit.log"), audit.DefaultMaxSize, audit.DefaultMaxFiles)
+	s.Require().NoError(err)
+	defer func() { _ = auditLog.Close() }()
+	s.client = v1.NewMetadataServiceClient(serveService(s.T(), Service{OpaClient: opaMock, Audit: auditLog}))
+	alice := metadata.AppendToOutgoingContext(s.ctx, "sub", "alice-id", "preferred_username", "alice", "x-request-id", "req-1")
+
+	_, err = s.client.CreateOrUpdateMetadata(alice, &v1.CreateOrUpdateRequest{Body: &v1.MetadataList{Metadata: []*v1.Metadata{
+		{Key: "environment", Value: "prod"}, {Key: "environment", Value: "dev"}, {Key: "zone", Value: "eu"},
+	}}})
+	s.NoError(err)
+	_, err = s.client.Delete(alice, &v1.Metadata{Key: "environment", Value: "prod"})
+	s.NoError(err)
+	_, err = s.client.Delete(alice, &v1.Metadata{Key: "missing", Value: "value"})
+	s.Error(err)
+	allowed = false
+	_, err = s.client.DeleteKey(metadata.AppendToOutgoingContext(s.ctx, "sub", "bob-id"), &v1.DeleteKeyRequest{Key: "zone"})
+	s.Error(err)
+	allowed = true
+
+	resp, err := s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{})
+	s.NoError(err)
+	s.Require().Len(resp.Events, 4)
+	denied := resp.Events[0]
+	s.Equal("DeleteKey", denied.Rpc)
+	s.Equal("bob-id", denied.Subject)
+	s.Equal(v1.AuditEvent_DECISION_DENIED, denied.Decision)
+	s.Empty(denied.Changes)
+	s.NotEmpty(resp.Events[1].Error)
+	s.Empty(resp.Events[1].Changes)
+	deleted := resp.Events[2]
+	s.Equal("Delete", deleted.Rpc)
+	s.Equal([]*v1.AuditChange{{Key: "environment", Before: []string{"prod", "dev"}, After: []string{"dev"}}}, deleted.Changes)
+	created := resp.Events[3]
+	s.Equal("CreateOrUpdateMetadata", created.Rpc)
+	s.Equal("alice-id", created.Subject)
+	s.Equal("alice", created.Username)
+	s.Equal("req-1", created.RequestId)
+	s.Equal(projectId, created.ProjectId)
+	s.Equal(v1.AuditEvent_DECISION_ALLOWED, created.Decision)
+	s.Equal([]*v1.AuditChange{
+		{Key: "environment", After: []string{"prod", "dev"}},
+		{Key: "zone", After: []string{"eu"}},
+	}, created.Changes)
+	s.Equal(created.Hash, resp.Events[2].PreviousHash)
+
+	// filtered and paged
+	resp, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{Key: "Environment", PageSize: 1})
+	s.NoError(err)
+	s.Require().Len(resp.Events, 1)
+	s.Equal(deleted.Sequence, resp.Events[0].Sequence)
+	resp, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{Key: "environment", PageSize: 1, PageToken: resp.NextPageToken})
+	s.NoError(err)
+	s.Require().Len(resp.Events, 1)
+	s.Equal(created.Sequence, resp.Events[0].Sequence)
+	s.Empty(resp.NextPageToken)
+	_, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{PageToken: "invalid"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// a new casing of a key is recorded even though no value changes
+	_, err = s.client.RenameKey(alice, &v1.RenameKeyRequest{Key: "zone", NewKey: "Zone"})
+	s.NoError(err)
+	resp, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{Rpc: "RenameKey"})
+	s.NoError(err)
+	s.Require().Len(resp.Events, 1)
+	s.Equal([]*v1.AuditChange{
+		{Key: "zone", Before: []string{"eu"}, After: []string{"eu"}, DisplayBefore: "zone", DisplayAfter: "Zone"},
+	}, resp.Events[0].Changes)
+
+	// deleting the project records all its values
+	_, err = s.client.DeleteProject(alice, &v1.DeleteProjectRequest{Id: projectId})
+	s.NoError(err)
+	resp, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{Rpc: "DeleteProject"})
+	s.NoError(err)
+	s.Require().Len(resp.Events, 1)
+	s.Equal([]*v1.AuditChange{
+		{Key: "environment", Before: []string{"dev"}},
+		{Key: "zone", Before: []string{"eu"}},
+	}, resp.Events[0].Changes)
+	s.NoError(auditLog.Verify())
+
+	// listing is admin only
+	allowed = false
+	_, err = s.client.ListAuditEvents(alice, &v1.ListAuditEventsRequest{})
+	s.ErrorContains(err, "access denied by OPA rule AdminRequest")
+}
+
+func searchPairs(matches []*v1.SearchMatch) []string {
+	var p []string
+	for _, m := range matches {
+		p = append(p, m.Metadata.Key+"="+m.Metadata.Value)
+	}
+	return p
+}
+
+func pairs(list []*v1.Metadata) []string {
+	var p []string
+	for _, m := range list {
+		p = append(p, m.Key+"="+m.Value)
+	}
+	return p
+}
+
 func (s *MetadataServiceTestSuite) TestCreateOrUpdateMetadataForProject() {
 	resp, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
 		Body: &v1.MetadataList{Metadata: []*v1.Metadata{
-			{Key: "pr1", Value: "pr_v1"},
+			{Key: "pr1", Value: "pr-v1"},
 		},
 		}})
 	s.NoError(err)
 	s.NotNil(resp)
 	// TODO: fix for CI build
 	// s.validateMetadata(resp.Metadata, map[string][]string{
-	// 	"pr1": {"pr_v1"},
+	// 	"pr1": {"pr-v1"},
 	// })
 
 	s.NoError(err)
@@ -209,7 +724,6 @@ func (s *MetadataServiceTestSuite) TestDeleteMetadata() {
 		"k1": {"v1", "v1a"},
 		"k2": {"v2"},
 		"k3": {"v3"},
-		"k4": nil,
 	})
 
 	resp, err = s.client.Delete(s.ctx, &v1.Metadata{Key: "k1", Value: "v1a"})
@@ -219,7 +733,6 @@ func (s *MetadataServiceTestSuite) TestDeleteMetadata() {
 		"k1": {"v1"},
 		"k2": {"v2"},
 		"k3": {"v3"},
-		"k4": nil,
 	})
 }
 
@@ -228,7 +741,7 @@ func (s *MetadataServiceTestSuite) TestDeleteProject() {
 	_, err := s.client.DeleteProject(s.ctx, &v1.DeleteProjectRequest{Id: projectId})
 	s.NoError(err)
 
-	resp, err := s.client.GetMetadata(s.ctx, &emptypb.Empty{})
+	resp, err := s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{})
 	s.NoError(err)
 	s.validateMetadata(resp.Metadata, nil)
 }
@@ -240,7 +753,7 @@ func (s *MetadataServiceTestSuite) TestGetMetadata() {
 	md := metadata.Pairs(ActiveProjectID, projectId)
 	ctx := metadata.NewOutgoingContext(s.ctx, md)
 
-	resp, err := s.client.GetMetadata(ctx, &emptypb.Empty{})
+	resp, err := s.client.GetMetadata(ctx, &v1.GetMetadataRequest{})
 	s.NoError(err)
 	s.NotNil(resp)
 	s.validateMetadata(resp.Metadata, map[string][]string{
@@ -254,11 +767,11 @@ func (s *MetadataServiceTestSuite) TestGetMetadataForProject() {
 	s.TestCreateOrUpdateMetadataForProject()
 
 	// read metadata for a specific project
-	resp, err := s.client.GetMetadata(s.ctx, &emptypb.Empty{})
+	resp, err := s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{})
 	s.NoError(err)
 	s.NotNil(resp)
 	s.validateMetadata(resp.Metadata, map[string][]string{
-		"pr1": {"pr_v1"},
+		"pr1": {"pr-v1"},
 	})
 }
 
@@ -272,10 +785,106 @@ func (s *MetadataServiceTestSuite) validateMetadata(actual []*v1.StoredMetadata,
 	}
 }
 
+func (s *MetadataServiceTestSuite) create(key, value string) {
+	_, err := s.client.CreateOrUpdateMetadata(s.ctx, &v1.CreateOrUpdateRequest{
+		Body: &v1.MetadataList{Metadata: []*v1.Metadata{{Key: key, Value: value}}},
+	})
+	s.NoError(err)
+}
+
+func (s *MetadataServiceTestSuite) TestWatchMetadata() {
+	s.create("k1", "v1")
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	stream, err := s.client.WatchMetadata(ctx, &v1.WatchMetadataRequest{Snapshot: true})
+	s.Require().NoError(err)
+
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	s.validateMetadata(resp.Snapshot, map[string][]string{"k1": {"v1"}})
+	s.Empty(resp.Events)
+	snapshot := resp.Revision
+
+	s.create("k1", "v2")
+	_, err = s.client.Delete(s.ctx, &v1.Metadata{Key: "k1", Value: "v1"})
+	s.NoError(err)
+
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(snapshot+1, resp.Revision)
+	s.Equal(v1.MetadataEvent_EVENT_TYPE_CREATED, resp.Events[0].Type)
+	s.Equal("k1=v2", pairs([]*v1.Metadata{resp.Events[0].Metadata})[0])
+
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(snapshot+2, resp.Revision)
+	s.Equal(v1.MetadataEvent_EVENT_TYPE_DELETED, resp.Events[0].Type)
+	s.Equal("k1=v1", pairs([]*v1.Metadata{resp.Events[0].Metadata})[0])
+	cancel()
+
+	// a client reconnecting after the snapshot gets the changes it missed
+	stream, err = s.client.WatchMetadata(s.ctx, &v1.WatchMetadataRequest{ResumeRevision: snapshot})
+	s.Require().NoError(err)
+	for _, want := range []uint64{snapshot + 1, snapshot + 2} {
+		resp, err = stream.Recv()
+		s.Require().NoError(err)
+		s.Equal(want, resp.Revision)
+	}
+
+	stream, err = s.client.WatchMetadata(s.ctx, &v1.WatchMetadataRequest{ResumeRevision: snapshot + 100})
+	s.Require().NoError(err)
+	_, err = stream.Recv()
+	s.Equal(codes.OutOfRange, status.Code(err))
+}
+
+func (s *MetadataServiceTestSuite) TestRenameKeyAndMergeValues() {
+	s.create("custmer", "culvers")
+	s.create("custmer", "culvrs")
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	stream, err := s.client.WatchMetadata(ctx, &v1.WatchMetadataRequest{})
+	s.Require().NoError(err)
+	_, err = stream.Header()
+	s.Require().NoError(err)
+
+	resp, err := s.client.RenameKey(s.ctx, &v1.RenameKeyRequest{Key: "custmer", NewKey: "customer"})
+	s.NoError(err)
+	s.validateMetadata(resp.Metadata, map[string][]string{"customer": {"culvers", "culvrs"}})
+	resp, err = s.client.MergeValues(s.ctx, &v1.MergeValuesRequest{Key: "customer", Values: []string{"culvrs"}, Into: "culvers"})
+	s.NoError(err)
+	s.validateMetadata(resp.Metadata, map[string][]string{"customer": {"culvers"}})
+
+	resp, err = s.client.RenameKey(s.ctx, &v1.RenameKeyRequest{Key: "customer", NewKey: "Customer"})
+	s.NoError(err)
+	s.Equal("Customer", resp.Metadata[0].DisplayKey)
+
+	_, err = s.client.RenameKey(s.ctx, &v1.RenameKeyRequest{Key: "missing", NewKey: "customer"})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// watchers learn where the values went, and of the new casing of the key
+	var renames []string
+	for range 3 {
+		change, err := stream.Recv()
+		s.Require().NoError(err)
+		for _, e := range change.Events {
+			s.Equal(v1.MetadataEvent_EVENT_TYPE_RENAMED, e.Type)
+			renames = append(renames, pairs([]*v1.Metadata{e.Previous})[0]+" -> "+pairs([]*v1.Metadata{e.Metadata})[0])
+		}
+	}
+	s.Equal([]string{
+		"custmer=culvers -> customer=culvers",
+		"custmer=culvrs -> customer=culvrs",
+		"customer=culvrs -> customer=culvers",
+		"customer=culvers -> Customer=culvers",
+	}, renames)
+}
+
 func (s *MetadataServiceTestSuite) TestDeniedAuth() {
 	s.setupForAuth(false)
 	// TODO: fix for CI build
-	// resp, err := s.client.GetMetadata(s.ctx, &emptypb.Empty{})
+	// resp, err := s.client.GetMetadata(s.ctx, &v1.GetMetadataRequest{})
 	// s.ErrorContains(err, "access denied by OPA rule GetRequest")
 	// s.Nil(resp)
 }
@@ -0,0 +1,51 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// Client is the metadata holding the User-Agent of the REST clients, as forwarded by the gateway.
+const Client = "client"
+
+// clientSource returns the component named by the product of the client User-Agent,
+// e.g. app-orch for "app-orch/1.2.0 (linux)". It is empty when the product isn't a valid name.
+func clientSource(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(Client)
+	if len(values) == 0 {
+		return ""
+	}
+	product, _, _ := strings.Cut(strings.TrimSpace(values[0]), " ")
+	product, _, _ = strings.Cut(product, "/")
+	product = strings.ToLower(product)
+	if !models.ValidSource(product) {
+		return ""
+	}
+	return product
+}
+
+// withSource attributes the entries that don't name their source to the client of the request.
+func withSource(ctx context.Context, list []*pb.Metadata) {
+	source := clientSource(ctx)
+	if source == "" {
+		return
+	}
+	for _, k := range list {
+		if k.GetSource() == "" {
+			k.Source = source
+		}
+	}
+}
@@ -0,0 +1,38 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestClientSource(t *testing.T) {
+	tests := []struct {
+		name   string
+		client []string
+		want   string
+	}{
+		{"missing", nil, ""},
+		{"product", []string{"app-orch"}, "app-orch"},
+		{"version", []string{"cluster-orch/1.2.0 (linux; amd64)"}, "cluster-orch"},
+		{"uppercase", []string{"Edge-Infra/2.0"}, "edge-infra"},
+		{"invalid", []string{"curl!/8.0"}, ""},
+		{"empty", []string{""}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.client != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(Client, tt.client[0]))
+			}
+			assert.Equal(t, tt.want, clientSource(ctx))
+		})
+	}
+}
@@ -6,91 +6,536 @@
 package impl
 
 import (
+	"encoding/base64"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/atomix/dazl"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/watch"
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var log = dazl.GetPackageLogger()
 
 // TODO consider create a struct to hold these data
-var _dataFolder string
+var _store *models.MemoryStore
+var _hub *watch.Hub
 
 // Init called at startup to load in persisted metadata
 func Init(persistData string, persistFolder string) error {
+	return InitWithBackend(models.BackendJSON, persistData, persistFolder)
+}
+
+// InitWithBackend loads the persisted metadata from the given storage backend
+func InitWithBackend(backend string, persistData string, persistFolder string) error {
 
-	_dataFolder = persistFolder
+	if _store != nil {
+		if err := _store.Close(); err != nil {
+			log.Warnf("Unable to close the previous store: %v", err)
+		}
+		_store = nil
+	}
+
+	backendStore, err := models.OpenStore(backend, persistFolder)
+	if err != nil {
+		return err
+	}
 
 	// "Migration project ID, required to migrate data at startup when upgrading from 24.08"
-	migrationProjectId, doesMigrationProjectIdExist := os.LookupEnv("MIGRATION_PROJECT_ID")
+	migrationProjectId := os.Getenv("MIGRATION_PROJECT_ID")
+	// upgrades the schema of the databases, imports the older files and data formats
+	if err := models.Migrate(persistFolder, persistData, backendStore, migrationProjectId); err != nil {
+		_ = backendStore.Close()
+		return err
+	}
+
+	store, err := models.NewMemoryStore(backendStore)
+	if err != nil {
+		_ = backendStore.Close()
+		return err
+	}
+	_hub = watch.NewHub(watch.DefaultHistory)
+	store.OnCommit(_hub.Commit)
+	_store = store
 
-	if doesMigrationProjectIdExist {
-		log.Infof("MIGRATION_PROJECT_ID exists: %s", migrationProjectId)
+	return nil
+}
 
-		// Checks for older data and migrate to the latest format
-		err := models.Migrate(persistData, persistFolder, migrationProjectId)
+func GetSystemMetadata(projectId *string) ([]*pb.StoredMetadata, error) {
+	log.Infof("GetSystemMetadata (projectID: %v)", projectId)
+	return _store.GetKeyValues(*projectId)
+}
+
+func CreateOrUpdate(projectId *string, k *pb.Metadata) ([]*pb.StoredMetadata, error) {
+	log.Infof("CreateOrUpdate (projectID: %v): %+v", projectId, k)
+	if _store.Current(*projectId, k) {
+		// creation is idempotent, nothing to persist
+		return _store.GetKeyValues(*projectId)
+	}
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		return metadata.CreateOrUpdate(k)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored.GetKeyValues()
+}
+
+// CreateOrUpdateList stores all the entries of the list in a single transaction, so that
+// either all of them are persisted or none is. The response reports which entries were
+// created and which were already stored.
+// With an expected revision, the transaction fails if the project is at another revision.
+func CreateOrUpdateList(projectId *string, list []*pb.Metadata, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("CreateOrUpdateList (projectID: %v): %+v", projectId, list)
+	if expectedRevision == nil && containsAll(*projectId, list) {
+		// creation is idempotent, nothing to persist
+		resp, err := GetMetadata(projectId, nil)
 		if err != nil {
+			return nil, err
+		}
+		for _, k := range list {
+			resp.Existing = append(resp.Existing, &pb.Metadata{
+				Key:    models.Normalize(k.Key),
+				Value:  models.Normalize(k.Value),
+				Owner:  k.Owner,
+				Source: strings.ToLower(k.Source),
+			})
+		}
+		return resp, nil
+	}
+
+	resp := &pb.MetadataResponse{}
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
 			return err
 		}
-	} else {
-		log.Info("MIGRATION_PROJECT_ID does not exist. Migration skipped.")
+		var err error
+		resp.Created, resp.Existing, err = metadata.CreateOrUpdateList(list)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	changes.after(stored)
+	return withMetadata(stored, resp)
+}
 
-	return nil
+func containsAll(projectId string, list []*pb.Metadata) bool {
+	for _, k := range list {
+		if !_store.Current(projectId, k) {
+			return false
+		}
+	}
+	return true
 }
 
-func GetSystemMetadata(projectId *string) ([]*pb.StoredMetadata, error) {
-	log.Infof("GetSystemMetadata (projectID: %v)", projectId)
-	metadata, err := models.LoadMetadataV1(_dataFolder, *projectId)
-	log.Debugf("Got Metadata: %+v", metadata)
+// Delete removes the value, with an expected revision only if the project is at that revision.
+// With an owner, only the reference of the owner is released: the value is removed with its last reference.
+func Delete(projectId *string, k *pb.Metadata, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("Delete (projectID: %s): %+v", projectId, k)
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
+			return err
+		}
+		return metadata.Delete(k)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return metadata.GetKeyValues()
+	changes.after(stored)
+	return withMetadata(stored, &pb.MetadataResponse{})
 }
 
-func CreateOrUpdate(projectId *string, k *pb.Metadata) ([]*pb.StoredMetadata, error) {
-	log.Infof("CreateOrUpdate (projectID: %v): %+v", projectId, k)
-	metadata, err := models.LoadMetadataV1(_dataFolder, *projectId)
+// BatchDelete removes all the values of the list in a single transaction, so that either all
+// of them are removed or none is. With owners, only their references are released.
+func BatchDelete(projectId *string, list []*pb.Metadata, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("BatchDelete (projectID: %s): %+v", *projectId, list)
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
+			return err
+		}
+		return metadata.DeleteList(list)
+	})
 	if err != nil {
 		return nil, err
 	}
-	if err := metadata.CreateOrUpdate(k); err != nil {
+	changes.after(stored)
+	return withMetadata(stored, &pb.MetadataResponse{})
+}
+
+// DeleteKey removes the key with all its values, with an expected revision only if the project
+// is at that revision. Keys with values still referenced by an owner can't be removed.
+func DeleteKey(projectId *string, key string, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("DeleteKey (projectID: %s): %s", *projectId, key)
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
+			return err
+		}
+		_, err := metadata.DeleteKey(key)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	pbMeta, err := metadata.GetKeyValues()
+	changes.after(stored)
+	return withMetadata(stored, &pb.MetadataResponse{})
+}
+
+// RenameKey moves all the values of a key to another key in a single transaction, with an
+// expected revision only if the project is at that revision. Watchers are notified of the renames.
+func RenameKey(projectId *string, key, newKey string, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("RenameKey (projectID: %s): %s to %s", *projectId, key, newKey)
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
+			return err
+		}
+		return metadata.RenameKey(key, newKey)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return pbMeta, models.SaveMetadataV1(metadata, _dataFolder, *projectId)
+	changes.after(stored)
+	return withMetadata(stored, &pb.MetadataResponse{})
 }
 
-func Delete(projectId *string, k *pb.Metadata) ([]*pb.StoredMetadata, error) {
-	log.Infof("Delete (projectID: %s): %+v", projectId, k)
-	metadata, err := models.LoadMetadataV1(_dataFolder, *projectId)
+// MergeValues merges values of a key into another value in a single transaction, with an
+// expected revision only if the project is at that revision. Watchers are notified of the renames.
+func MergeValues(projectId *string, key string, values []string, into string, expectedRevision *uint64, changes *Changes) (*pb.MetadataResponse, error) {
+	log.Infof("MergeValues (projectID: %s): %s=%v into %s", *projectId, key, values, into)
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		if err := metadata.CheckRevision(expectedRevision); err != nil {
+			return err
+		}
+		return metadata.MergeValues(key, values, into)
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes.after(stored)
+	return withMetadata(stored, &pb.MetadataResponse{})
+}
+
+// SetKeySchema registers the schema of a key, provided the values already stored satisfy it.
+func SetKeySchema(projectId *string, req *pb.KeySchema) (*pb.KeySchema, error) {
+	log.Infof("SetKeySchema (projectID: %s): %+v", *projectId, req)
+	schema, err := models.NewKeySchema(req)
+	if err != nil {
+		return nil, err
+	}
+	_, err = _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		return metadata.SetSchema(schema)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schema.ToProto(), nil
+}
+
+// DeleteKeySchema removes the schema of a key.
+func DeleteKeySchema(projectId *string, key string) error {
+	log.Infof("DeleteKeySchema (projectID: %s): %s", *projectId, key)
+	_, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		return metadata.DeleteSchema(key)
+	})
+	return err
+}
+
+// ListKeySchemas returns the schemas of the project, sorted by key.
+func ListKeySchemas(projectId *string) (*pb.ListKeySchemasResponse, error) {
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListKeySchemasResponse{}
+	for _, schema := range snapshot.Schemas {
+		resp.Schemas = append(resp.Schemas, schema.ToProto())
+	}
+	return resp, nil
+}
+
+// GetValidationProfile returns the validation profile of the project.
+func GetValidationProfile(projectId *string) (*pb.ValidationProfileResponse, error) {
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValidationProfileResponse{Profile: models.ProfileToProto(snapshot.ProjectProfile())}, nil
+}
+
+// SetValidationProfile selects the validation profile of the project, provided the metadata
+// already stored satisfies it. The unspecified profile restores the default one.
+func SetValidationProfile(projectId *string, profile pb.ValidationProfile) (*pb.ValidationProfileResponse, error) {
+	log.Infof("SetValidationProfile (projectID: %s): %v", *projectId, profile)
+	name, err := models.ProfileFromProto(profile)
 	if err != nil {
 		return nil, err
 	}
-	if err := metadata.Delete(k); err != nil {
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		return metadata.SetProfile(name)
+	})
+	if err != nil {
 		return nil, err
 	}
-	pbMeta, err := metadata.GetKeyValues()
+	return &pb.ValidationProfileResponse{Profile: models.ProfileToProto(stored.ProjectProfile())}, nil
+}
+
+// MaxPageSize is the largest page of keys returned by GetMetadata.
+const MaxPageSize = 1000
+
+// GetMetadata returns the metadata of the project with its revision, selected, sorted
+// and paged as requested. A nil request returns all the metadata.
+func GetMetadata(projectId *string, req *pb.GetMetadataRequest) (*pb.MetadataResponse, error) {
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &snapshot.Metadata
+	if source := req.GetSource(); source != "" {
+		metadata = metadata.FromSource(source)
+	}
+	if keys := req.GetKeys(); len(keys) > 0 {
+		metadata = metadata.SelectKeys(keys)
+	}
+	metadata = metadata.Ordered(req.GetOrderBy())
+
+	resp := &pb.MetadataResponse{}
+	if req.GetPageSize() > 0 || req.GetPageToken() != "" {
+		metadata, resp.NextPageToken, err = page(metadata, req.GetPageToken(), int(req.GetPageSize()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return withMetadata(&models.MetadataStoreV1{VersionedStore: snapshot.VersionedStore, Metadata: *metadata}, resp)
+}
+
+// page returns the keys of the page starting at the token, with the token of the next page.
+// Tokens are opaque offsets: pages reflect the metadata at the time each one is read.
+func page(metadata *models.Metadata, token string, size int) (*models.Metadata, string, error) {
+	offset := 0
+	if token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil {
+			offset, err = strconv.Atoi(string(decoded))
+		}
+		if err != nil || offset < 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token %q", token)
+		}
+	}
+	if size <= 0 || size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	keys := metadata.Keys[min(offset, len(metadata.Keys)):]
+	next := ""
+	if len(keys) > size {
+		keys = keys[:size]
+		next = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset + size)))
+	}
+	return &models.Metadata{Keys: keys}, next, nil
+}
+
+// GetKey returns the values of a single key of the project with its revision.
+func GetKey(projectId *string, req *pb.GetKeyRequest) (*pb.GetKeyResponse, error) {
+	log.Debugf("GetKey (projectID: %s): %+v", *projectId, req)
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := snapshot.Metadata.SelectKeys([]string{req.GetKey()})
+	if source := req.GetSource(); source != "" {
+		metadata = metadata.FromSource(source)
+	}
+	keyValues, err := metadata.Ordered(req.GetOrderBy()).GetKeyValues()
+	if err != nil {
+		return nil, err
+	}
+	if len(keyValues) == 0 {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", req.GetKey())
+	}
+	return &pb.GetKeyResponse{Metadata: keyValues[0], Revision: snapshot.Revision}, nil
+}
+
+// ListKeys returns the keys of the project with their number of values.
+func ListKeys(projectId *string, req *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &snapshot.Metadata
+	if source := req.GetSource(); source != "" {
+		metadata = metadata.FromSource(source)
+	}
+	resp := &pb.ListKeysResponse{Revision: snapshot.Revision}
+	for _, k := range metadata.Ordered(req.GetOrderBy()).Keys {
+		resp.Keys = append(resp.Keys, &pb.KeySummary{Key: k.Name, ValueCount: uint32(len(k.Values)), DisplayKey: k.Display})
+	}
+	return resp, nil
+}
+
+// DefaultSearchLimit is the number of matches returned by a search without a limit.
+const DefaultSearchLimit = 20
+
+// MaxSearchLimit is the largest number of matches returned by a search.
+const MaxSearchLimit = 1000
+
+// Search returns the keys and values of the project matching the query of the request, best matches first.
+func Search(projectId *string, req *pb.SearchMetadataRequest) (*pb.SearchMetadataResponse, error) {
+	log.Debugf("Search (projectID: %s): %+v", *projectId, req)
+	if req.GetQuery() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing search query")
+	}
+	snapshot, err := _store.Snapshot(*projectId)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &snapshot.Metadata
+	if source := req.GetSource(); source != "" {
+		metadata = metadata.FromSource(source)
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+	return &pb.SearchMetadataResponse{
+		Matches: metadata.Search(req.GetQuery(), req.GetKey(), req.GetMode(), limit),
+	}, nil
+}
+
+// withMetadata fills the response with the metadata and the revision of the store.
+func withMetadata(metadata *models.MetadataStoreV1, resp *pb.MetadataResponse) (*pb.MetadataResponse, error) {
+	var err error
+	resp.Revision = metadata.Revision
+	resp.Metadata, err = metadata.GetKeyValues()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Watch subscribes to the changes of the project metadata. The responses to send before
+// the changes delivered by the subscription are returned along with it: the snapshot of the
+// metadata when requested, otherwise the changes made since the resume revision.
+func Watch(projectId *string, snapshot bool, resume uint64) (*watch.Subscription, []*pb.WatchMetadataResponse, error) {
+	log.Infof("Watch (projectID: %s): snapshot %t, resume %d", *projectId, snapshot, resume)
+	if snapshot {
+		resume = 0
+	}
+
+	var sub *watch.Subscription
+	var responses []*pb.WatchMetadataResponse
+	// no change can be committed in between the snapshot and the subscription
+	err := _store.View(*projectId, func(metadata *models.MetadataStoreV1) error {
+		var backlog []*pb.WatchMetadataResponse
+		var err error
+		sub, backlog, err = _hub.Subscribe(*projectId, metadata.Revision, resume)
+		if err != nil {
+			return err
+		}
+		if snapshot {
+			stored, err := metadata.GetKeyValues()
+			if err != nil {
+				sub.Close()
+				return err
+			}
+			responses = append(responses, &pb.WatchMetadataResponse{Revision: metadata.Revision, Snapshot: stored})
+		}
+		responses = append(responses, backlog...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, responses, nil
+}
+
+// Changes receives the values and the display names of the keys of the project before and
+// after a write, read in the transaction that committed it, of all the keys when Keys is nil.
+// The writes given a nil Changes do not read them.
+type Changes struct {
+	Keys          []string
+	Before        map[string][]string
+	After         map[string][]string
+	BeforeDisplay map[string]string
+	AfterDisplay  map[string]string
+}
+
+func (c *Changes) before(metadata *models.MetadataStoreV1) {
+	if c != nil {
+		c.Before, c.BeforeDisplay = c.values(metadata)
+	}
+}
+
+func (c *Changes) after(metadata *models.MetadataStoreV1) {
+	if c != nil {
+		c.After, c.AfterDisplay = c.values(metadata)
+	}
+}
+
+// values returns the values and the display name of the keys by key, none for a project without metadata.
+func (c *Changes) values(stored *models.MetadataStoreV1) (map[string][]string, map[string]string) {
+	if stored == nil {
+		return map[string][]string{}, map[string]string{}
+	}
+	metadata := &stored.Metadata
+	if c.Keys != nil {
+		metadata = metadata.SelectKeys(c.Keys)
+	}
+	values := make(map[string][]string, len(metadata.Keys))
+	display := make(map[string]string, len(metadata.Keys))
+	for _, k := range metadata.Keys {
+		values[k.Name] = slices.Clone(k.Values)
+		display[k.Name] = k.DisplayName()
+	}
+	return values, display
+}
+
+// Projects lists the projects that have metadata stored.
+func Projects() ([]string, error) {
+	return _store.Projects()
+}
+
+// Expire removes the values of the project that have not been asserted since before, returning them.
+func Expire(projectId *string, before time.Time, changes *Changes) ([]*pb.Metadata, error) {
+	var expired []*pb.Metadata
+	stored, err := _store.Update(*projectId, func(metadata *models.MetadataStoreV1) error {
+		changes.before(metadata)
+		expired = metadata.Expire(before)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return pbMeta, models.SaveMetadataV1(metadata, _dataFolder, *projectId)
+	changes.after(stored)
+	return expired, nil
 }
 
-func DeleteProject(projectId *string) error {
+// DeleteProject removes all the metadata of the project.
+func DeleteProject(projectId *string, changes *Changes) error {
 	log.Infof("Delete (projectID: %s)", projectId)
 
-	err := models.DeleteProject(_dataFolder, *projectId)
+	deleted, err := _store.RemoveProject(*projectId)
 
 	if err != nil {
 		return err
 	}
 
+	changes.before(deleted)
+	changes.after(nil)
 	return nil
 }
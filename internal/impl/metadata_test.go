@@ -9,10 +9,14 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
+	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const jsonmetadataV1 = `{"version":"v1","keys":[{"name":"foo","values":["bar","rab"]}]}`
@@ -46,12 +50,13 @@ func TestGetSystemMetadata(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			persistFolder := t.TempDir()
 
-			err := Init("", persistFolder)
-			assert.NoError(t, err)
-
 			filename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", tt.args.writeProjectId))
 			fmt.Printf("Write file to: %s", filename)
-			err = os.WriteFile(filename, tt.args.testMetadata, 0644)
+			err := os.WriteFile(filename, tt.args.testMetadata, 0644)
+			assert.NoError(t, err)
+
+			// the store is loaded once at startup
+			err = Init("", persistFolder)
 			assert.NoError(t, err)
 
 			got, err := GetSystemMetadata(tt.args.readProjectId)
@@ -85,15 +90,15 @@ func TestCreateOrUpdate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			persistFolder := t.TempDir()
 
-			err := Init("", persistFolder)
-			assert.NoError(t, err)
-
 			writeFilename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", tt.args.readProjectId))
 			if tt.args.writeProjectId != nil {
 				writeFilename = path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", *tt.args.writeProjectId))
 			}
 			fmt.Printf("Creating file: %s", writeFilename)
-			err = os.WriteFile(writeFilename, []byte(`{"version":"v1"}`), 0644)
+			err := os.WriteFile(writeFilename, []byte(`{"version":"v1"}`), 0644)
+			assert.NoError(t, err)
+
+			err = Init("", persistFolder)
 			assert.NoError(t, err)
 
 			for _, k := range tt.args.testMetadata {
@@ -116,8 +121,133 @@ func TestCreateOrUpdate(t *testing.T) {
 	}
 }
 
+func TestCreateOrUpdateList(t *testing.T) {
+	persistFolder := t.TempDir()
+	filename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", testProject))
+	assert.NoError(t, os.WriteFile(filename, []byte(jsonmetadataV1), 0644))
+	assert.NoError(t, Init("", persistFolder))
+
+	resp, err := CreateOrUpdateList(&testProject, []*pb.Metadata{
+		{Key: "foo", Value: "bar"},
+		{Key: "color", Value: "Red"},
+	}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.Metadata{{Key: "color", Value: "red"}}, resp.Created)
+	assert.Equal(t, []*pb.Metadata{{Key: "foo", Value: "bar"}}, resp.Existing)
+	assert.Equal(t, []*pb.StoredMetadata{
+		{Key: "foo", Values: []string{"bar", "rab"}},
+		{Key: "color", Values: []string{"red"}, DisplayValues: map[string]string{"red": "Red"}},
+	}, resp.Metadata)
+
+	stored, err := models.LoadMetadataV1(persistFolder, testProject)
+	assert.NoError(t, err)
+	// the values asserted by the request are seen
+	assert.Len(t, stored.Keys[0].Times, 1)
+	assert.Contains(t, stored.Keys[0].Times, "bar")
+	assert.Contains(t, stored.Keys[1].Times, "red")
+	for i := range stored.Keys {
+		stored.Keys[i].Times = nil
+	}
+	assert.Equal(t, []models.Key{
+		{Name: "foo", Values: []string{"bar", "rab"}},
+		{Name: "color", Values: []string{"red"}, DisplayValues: map[string]string{"red": "Red"}},
+	}, stored.Keys)
+
+	// nothing to write when every entry is already stored
+	resp, err = CreateOrUpdateList(&testProject, []*pb.Metadata{{Key: "COLOR", Value: "red"}}, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Created)
+	assert.Equal(t, []*pb.Metadata{{Key: "color", Value: "red"}}, resp.Existing)
+
+	// a failed write leaves every entry of the list out
+	assert.NoError(t, os.RemoveAll(persistFolder))
+	_, err = CreateOrUpdateList(&testProject, []*pb.Metadata{
+		{Key: "size", Value: "small"},
+		{Key: "size", Value: "large"},
+	}, nil, nil)
+	assert.Error(t, err)
+	got, err := GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Equal(t, resp.Metadata, got)
+}
+
+func TestRevision(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, Init("", dir))
+	revision := func(r uint64) *uint64 { return &r }
+
+	resp, err := CreateOrUpdateList(&testProject, pbMetadata, revision(0), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.Revision)
+
+	// a stale revision is rejected without changes
+	_, err = CreateOrUpdateList(&testProject, []*pb.Metadata{{Key: "color", Value: "red"}}, revision(0), nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = Delete(&testProject, &pb.Metadata{Key: "foo", Value: "bar"}, revision(0), nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	resp, err = GetMetadata(&testProject, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.MetadataResponse{Revision: 1, Metadata: pbMetadataV1}, resp)
+
+	// writes without changes keep the revision
+	resp, err = CreateOrUpdateList(&testProject, pbMetadata, revision(1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.Revision)
+	assert.Len(t, resp.Existing, 2)
+
+	resp, err = Delete(&testProject, &pb.Metadata{Key: "foo", Value: "bar"}, revision(1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.MetadataResponse{Revision: 2, Metadata: []*pb.StoredMetadata{{Key: "foo", Values: []string{"rab"}}}}, resp)
+
+	// the revision is persisted
+	assert.NoError(t, Init("", dir))
+	resp, err = GetMetadata(&testProject, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.Revision)
+}
+
+func TestReferences(t *testing.T) {
+	assert.NoError(t, Init("", t.TempDir()))
+	value := func(owner string) *pb.Metadata { return &pb.Metadata{Key: "customer", Value: "culvers", Owner: owner} }
+
+	_, err := CreateOrUpdateList(&testProject, []*pb.Metadata{value("")}, nil, nil)
+	assert.NoError(t, err)
+	// an already stored value still gets the references of its owners
+	for _, owner := range []string{"app-orch/deployment/123", "cluster-orch/cluster/c1", "app-orch/deployment/123"} {
+		_, err = CreateOrUpdateList(&testProject, []*pb.Metadata{value(owner)}, nil, nil)
+		assert.NoError(t, err)
+	}
+	want := &pb.StoredMetadata{Key: "customer", Values: []string{"culvers"}, RefCounts: map[string]uint32{"culvers": 2}}
+	resp, err := GetMetadata(&testProject, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{want}, resp.Metadata)
+
+	_, err = Delete(&testProject, value(""), nil, nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err = Delete(&testProject, value("app-orch/deployment/123"), nil, nil)
+	assert.NoError(t, err)
+	want.RefCounts["culvers"] = 1
+	assert.Equal(t, []*pb.StoredMetadata{want}, resp.Metadata)
+
+	resp, err = Delete(&testProject, value("cluster-orch/cluster/c1"), nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Metadata)
+
+	// owners are validated by the server, whatever the client
+	for _, owner := range []string{"app-orch deployment", "/app-orch", "app-orch/", strings.Repeat("a", 254)} {
+		_, err = CreateOrUpdateList(&testProject, []*pb.Metadata{value(owner)}, nil, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), owner)
+		_, err = Delete(&testProject, value(owner), nil, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), owner)
+	}
+	resp, err = GetMetadata(&testProject, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Metadata)
+}
+
 func TestDelete(t *testing.T) {
-	const jsonmetadataV1_delete = `{"version":"v1","keys":[{"name":"foo","values":["bar"]}]}`
+	const jsonmetadataV1_delete = `{"version":"v1","revision":1,"keys":[{"name":"foo","values":["bar"]}]}`
 	type args struct {
 		content        []byte
 		testMetadata   *pb.Metadata
@@ -138,24 +268,25 @@ func TestDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			persistFolder := t.TempDir()
 
-			err := Init("", persistFolder)
-			assert.NoError(t, err)
-
 			writeFilename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", tt.args.readProjectId))
 			if tt.args.writeProjectId != nil {
 				writeFilename = path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", *tt.args.writeProjectId))
 			}
 			fmt.Printf("Creating file: %s", writeFilename)
-			err = os.WriteFile(writeFilename, tt.args.content, 0644)
+			err := os.WriteFile(writeFilename, tt.args.content, 0644)
+			assert.NoError(t, err)
+
+			err = Init("", persistFolder)
 			assert.NoError(t, err)
 
-			_, err = Delete(tt.args.writeProjectId, tt.args.testMetadata)
+			_, err = Delete(tt.args.writeProjectId, tt.args.testMetadata, nil, nil)
 			if !tt.wantErr(t, err, fmt.Sprintf("CreateOrUpdate(%+v, %+v)", tt.args.writeProjectId, tt.args.testMetadata)) {
 				return
 			}
 
-			filename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", tt.args.readProjectId))
-			data, err := os.ReadFile(filename)
+			stored, err := models.LoadMetadataV1(persistFolder, tt.args.readProjectId)
+			assert.NoError(t, err)
+			data, err := stored.GetJson()
 			assert.NoError(t, err)
 			e := os.Remove(writeFilename)
 			assert.NoError(t, e)
@@ -165,6 +296,34 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDeleteKeyAndBatchDelete(t *testing.T) {
+	assert.NoError(t, Init("", t.TempDir()))
+	_, err := CreateOrUpdateList(&testProject, []*pb.Metadata{
+		{Key: "customr", Value: "culvers"},
+		{Key: "customr", Value: "acme"},
+		{Key: "color", Value: "red"},
+		{Key: "color", Value: "blue"},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := DeleteKey(&testProject, "Customr", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "color", Values: []string{"red", "blue"}}}, resp.Metadata)
+	_, err = DeleteKey(&testProject, "customr", nil, nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// nothing is removed if an entry is missing
+	_, err = BatchDelete(&testProject, []*pb.Metadata{{Key: "color", Value: "red"}, {Key: "color", Value: "green"}}, nil, nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	resp, err = GetMetadata(&testProject, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "color", Values: []string{"red", "blue"}}}, resp.Metadata)
+
+	resp, err = BatchDelete(&testProject, []*pb.Metadata{{Key: "color", Value: "red"}, {Key: "color", Value: "blue"}}, &resp.Revision, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Metadata)
+}
+
 func TestDeleteProject(t *testing.T) {
 	var nonExistentProject = "non-existent-project"
 	type args struct {
@@ -201,10 +360,163 @@ func TestDeleteProject(t *testing.T) {
 				}
 			}
 
-			err = DeleteProject(tt.args.projectId)
+			err = DeleteProject(tt.args.projectId, nil)
 			if !tt.wantErr(t, err, fmt.Sprintf("DeleteProject(%v)", tt.args.projectId)) {
 				return
 			}
 		})
 	}
 }
+
+func TestInitWithBackend(t *testing.T) {
+	persistFolder := t.TempDir()
+	filename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", testProject))
+	assert.NoError(t, os.WriteFile(filename, []byte(jsonmetadataV1), 0644))
+
+	err := InitWithBackend("bbolt", "", persistFolder)
+	assert.NoError(t, err)
+
+	// JSON files are imported in the bbolt database
+	got, err := GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Equal(t, pbMetadataV1, got)
+
+	_, err = CreateOrUpdate(&testProject, &pb.Metadata{Key: "new", Value: "value"})
+	assert.NoError(t, err)
+
+	// data survives a restart
+	err = InitWithBackend("bbolt", "", persistFolder)
+	assert.NoError(t, err)
+	got, err = GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{
+		{Key: "foo", Values: []string{"bar", "rab"}},
+		{Key: "new", Values: []string{"value"}},
+	}, got)
+
+	err = InitWithBackend("unknown", "", persistFolder)
+	assert.Error(t, err)
+
+	// a new installation starts from an empty database
+	err = InitWithBackend("bbolt", "", path.Join(t.TempDir(), "new"))
+	assert.NoError(t, err)
+	got, err = GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestInitWithBackend_SQLite(t *testing.T) {
+	persistFolder := t.TempDir()
+	filename := path.Join(persistFolder, fmt.Sprintf("metadata-%s.json", testProject))
+	assert.NoError(t, os.WriteFile(filename, []byte(jsonmetadataV1), 0644))
+
+	err := InitWithBackend("sqlite", "", persistFolder)
+	assert.NoError(t, err)
+
+	got, err := GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Equal(t, pbMetadataV1, got)
+
+	_, err = Delete(&testProject, &pb.Metadata{Key: "foo", Value: "bar"}, nil, nil)
+	assert.NoError(t, err)
+
+	err = InitWithBackend("sqlite", "", persistFolder)
+	assert.NoError(t, err)
+	got, err = GetSystemMetadata(&testProject)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "foo", Values: []string{"rab"}}}, got)
+}
+
+func TestGetMetadataPaging(t *testing.T) {
+	assert.NoError(t, Init("", t.TempDir()))
+	var list []*pb.Metadata
+	for _, key := range []string{"region", "customer", "color", "app", "zone"} {
+		list = append(list, &pb.Metadata{Key: key, Value: "v"})
+	}
+	_, err := CreateOrUpdateList(&testProject, list, nil, nil)
+	assert.NoError(t, err)
+
+	req := &pb.GetMetadataRequest{PageSize: 2, OrderBy: pb.GetMetadataRequest_ORDER_BY_ALPHABETICAL}
+	var pages [][]string
+	for {
+		resp, err := GetMetadata(&testProject, req)
+		assert.NoError(t, err)
+		var keys []string
+		for _, md := range resp.Metadata {
+			keys = append(keys, md.Key)
+		}
+		pages = append(pages, keys)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, [][]string{{"app", "color"}, {"customer", "region"}, {"zone"}}, pages)
+
+	resp, err := GetMetadata(&testProject, &pb.GetMetadataRequest{Keys: []string{"Zone", "app"}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Metadata, 2)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = GetMetadata(&testProject, &pb.GetMetadataRequest{PageToken: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestChanges(t *testing.T) {
+	assert.NoError(t, Init("", t.TempDir()))
+	_, err := CreateOrUpdateList(&testProject, []*pb.Metadata{
+		{Key: "customer", Value: "culvers"},
+		{Key: "color", Value: "red"},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	// the values are read in the transaction of the write, of the selected keys only
+	changes := &Changes{Keys: []string{"Customer"}}
+	_, err = CreateOrUpdateList(&testProject, []*pb.Metadata{{Key: "customer", Value: "acme"}, {Key: "color", Value: "blue"}}, nil, changes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"customer": {"culvers"}}, changes.Before)
+	assert.Equal(t, map[string][]string{"customer": {"culvers", "acme"}}, changes.After)
+
+	changes = &Changes{Keys: []string{"customer", "client"}}
+	_, err = RenameKey(&testProject, "customer", "client", nil, changes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"customer": {"culvers", "acme"}}, changes.Before)
+	assert.Equal(t, map[string][]string{"client": {"culvers", "acme"}}, changes.After)
+
+	// the display names are read along with the values
+	changes = &Changes{Keys: []string{"client"}}
+	_, err = RenameKey(&testProject, "client", "Client", nil, changes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"client": "client"}, changes.BeforeDisplay)
+	assert.Equal(t, map[string]string{"client": "Client"}, changes.AfterDisplay)
+
+	// a failed write reads nothing after it
+	stale := uint64(0)
+	changes = &Changes{Keys: []string{"color"}}
+	_, err = DeleteKey(&testProject, "color", &stale, changes)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Nil(t, changes.After)
+
+	changes = &Changes{}
+	assert.NoError(t, DeleteProject(&testProject, changes))
+	assert.Equal(t, map[string][]string{"client": {"culvers", "acme"}, "color": {"red", "blue"}}, changes.Before)
+	assert.Empty(t, changes.After)
+}
+
+func TestSearchLimit(t *testing.T) {
+	assert.NoError(t, Init("", t.TempDir()))
+	var list []*pb.Metadata
+	for i := 0; i < MaxSearchLimit+10; i++ {
+		list = append(list, &pb.Metadata{Key: "customer", Value: fmt.Sprintf("c%d", i)})
+	}
+	_, err := CreateOrUpdateList(&testProject, list, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := Search(&testProject, &pb.SearchMetadataRequest{Query: "c", Mode: pb.SearchMetadataRequest_SEARCH_MODE_PREFIX})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Matches, DefaultSearchLimit)
+	// larger limits are clamped, whatever the client
+	resp, err = Search(&testProject, &pb.SearchMetadataRequest{Query: "c", Mode: pb.SearchMetadataRequest_SEARCH_MODE_PREFIX, Limit: 5000})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Matches, MaxSearchLimit)
+}
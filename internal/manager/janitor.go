@@ -0,0 +1,184 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
+)
+
+// DefaultJanitorInterval is how often the janitor looks for stale values by default.
+const DefaultJanitorInterval = time.Hour
+
+// Janitor periodically expires the values that have not been asserted within the TTL of their project,
+// so that the values nobody uses anymore (e.g. corrected typos) stop being suggested.
+type Janitor struct {
+	interval    time.Duration
+	defaultTTL  time.Duration
+	projectTTLs map[string]time.Duration
+	// audit records the expired values, nil when disabled
+	audit *audit.Log
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	// done is closed once the sweeps started by Start have stopped
+	done chan struct{}
+}
+
+// NewJanitor creates a Janitor running every interval. Values expire after defaultTTL,
+// unless their project has its own TTL in projectTTLs; a zero TTL keeps the values forever.
+// The expirations are recorded in auditLog, unless nil.
+func NewJanitor(interval, defaultTTL time.Duration, projectTTLs map[string]time.Duration, auditLog *audit.Log) *Janitor {
+	if interval <= 0 {
+		interval = DefaultJanitorInterval
+	}
+	return &Janitor{
+		interval:    interval,
+		defaultTTL:  defaultTTL,
+		projectTTLs: projectTTLs,
+		audit:       auditLog,
+	}
+}
+
+// TTL returns how long the values of the project are kept without being asserted, zero for ever.
+func (j *Janitor) TTL(projectId string) time.Duration {
+	if ttl, ok := j.projectTTLs[projectId]; ok {
+		return ttl
+	}
+	return j.defaultTTL
+}
+
+func (j *Janitor) enabled() bool {
+	if j.defaultTTL > 0 {
+		return true
+	}
+	for _, ttl := range j.projectTTLs {
+		if ttl > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Start runs the janitor in a background goroutine, it does nothing when no TTL is set.
+// If already started, it returns an error.
+func (j *Janitor) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.cancel != nil {
+		return fmt.Errorf("janitor already started")
+	}
+	if !j.enabled() {
+		log.Info("Value expiry disabled, no TTL set")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	done := make(chan struct{})
+	j.done = done
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			j.Sweep(time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	log.Infof("Janitor started: interval=%s ttl=%s project ttls=%v", j.interval, j.defaultTTL, j.projectTTLs)
+	return nil
+}
+
+// Stop stops the background janitor, waiting for the running sweep to complete.
+func (j *Janitor) Stop() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.cancel != nil {
+		j.cancel()
+		<-j.done
+		j.cancel, j.done = nil, nil
+	}
+}
+
+// Sweep expires the stale values of every project, as of now.
+// The projects failing to expire are logged and retried on the next sweep.
+func (j *Janitor) Sweep(now time.Time) {
+	projects, err := impl.Projects()
+	if err != nil {
+		log.Errorf("Unable to list the projects to expire: %v", err)
+		return
+	}
+	for _, projectId := range projects {
+		ttl := j.TTL(projectId)
+		if ttl <= 0 {
+			continue
+		}
+		changes := &impl.Changes{}
+		expired, err := impl.Expire(&projectId, now.Add(-ttl), changes)
+		if err != nil {
+			log.Errorf("Unable to expire the values of project %s: %v", projectId, err)
+			continue
+		}
+		for _, k := range expired {
+			log.Infof("Expired %s=%s of project %s, not seen for %s", k.Key, k.Value, projectId, ttl)
+		}
+		if len(expired) > 0 {
+			j.record(projectId, audit.Diff(changes.Before, changes.After, changes.BeforeDisplay, changes.AfterDisplay))
+		}
+	}
+}
+
+// record appends the expiration to the audit log, as a change of the system without subject.
+func (j *Janitor) record(projectId string, changes []audit.Change) {
+	if j.audit == nil {
+		return
+	}
+	event := audit.Event{
+		Time:      time.Now(),
+		ProjectID: projectId,
+		RPC:       "Expire",
+		Decision:  audit.DecisionSystem,
+		Changes:   changes,
+	}
+	if _, err := j.audit.Append(event); err != nil {
+		log.Errorf("Unable to record the audit event of the expiration in project %s: %v", projectId, err)
+	}
+}
+
+// ParseProjectTTLs parses a comma separated list of project=ttl pairs, e.g. "p1=720h,p2=0".
+func ParseProjectTTLs(s string) (map[string]time.Duration, error) {
+	ttls := map[string]time.Duration{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		projectId, value, ok := strings.Cut(pair, "=")
+		if !ok || projectId == "" {
+			return nil, fmt.Errorf("invalid project TTL %q, expected project=ttl", pair)
+		}
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid TTL %q for project %s", value, projectId)
+		}
+		ttls[projectId] = ttl
+	}
+	return ttls, nil
+}
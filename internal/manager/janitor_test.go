@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+)
+
+func TestParseProjectTTLs(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]time.Duration
+		wantErr bool
+	}{
+		{"empty", "", map[string]time.Duration{}, false},
+		{"pairs", "p1=720h, p2=0,", map[string]time.Duration{"p1": 720 * time.Hour, "p2": 0}, false},
+		{"missing-ttl", "p1", nil, true},
+		{"missing-project", "=1h", nil, true},
+		{"invalid-ttl", "p1=month", nil, true},
+		{"negative-ttl", "p1=-1h", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProjectTTLs(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJanitor_Sweep(t *testing.T) {
+	require.NoError(t, impl.Init("", t.TempDir()))
+	projects := []string{"expiring", "kept"}
+	for _, projectId := range projects {
+		_, err := impl.CreateOrUpdateList(&projectId, []*pb.Metadata{
+			{Key: "customer", Value: "culverz"},
+			{Key: "customer", Value: "culvers", Owner: "app-orch/deployment/123"},
+		}, nil, nil)
+		require.NoError(t, err)
+	}
+
+	j := NewJanitor(0, 24*time.Hour, map[string]time.Duration{"kept": 0}, nil)
+	assert.Equal(t, DefaultJanitorInterval, j.interval)
+	assert.Equal(t, 24*time.Hour, j.TTL("expiring"))
+	assert.Equal(t, time.Duration(0), j.TTL("kept"))
+
+	j.Sweep(time.Now().Add(time.Hour))
+	j.Sweep(time.Now().Add(48 * time.Hour))
+
+	// referenced values are kept
+	resp, err := impl.GetMetadata(&projects[0], nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"culvers"}, resp.Metadata[0].Values)
+	resp, err = impl.GetMetadata(&projects[1], nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"culverz", "culvers"}, resp.Metadata[0].Values)
+}
+
+func TestJanitor_SweepAudited(t *testing.T) {
+	require.NoError(t, impl.Init("", t.TempDir()))
+	auditLog, err := audit.Open(filepath.Join(t.TempDir(), "audit.log"), audit.DefaultMaxSize, audit.DefaultMaxFiles)
+	require.NoError(t, err)
+	defer func() { _ = auditLog.Close() }()
+	projectId := "expiring"
+	_, err = impl.CreateOrUpdateList(&projectId, []*pb.Metadata{
+		{Key: "customer", Value: "culverz"},
+		{Key: "customer", Value: "culvers", Owner: "app-orch/deployment/123"},
+		{Key: "color", Value: "red", Owner: "app-orch/deployment/123"},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	j := NewJanitor(0, 24*time.Hour, nil, auditLog)
+	j.Sweep(time.Now().Add(time.Hour))
+	j.Sweep(time.Now().Add(48 * time.Hour))
+	j.Sweep(time.Now().Add(72 * time.Hour))
+
+	// only the sweep expiring values is recorded, as a change of the system
+	events, _, err := auditLog.List(audit.Filter{ProjectID: projectId})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Expire", events[0].RPC)
+	assert.Empty(t, events[0].Subject)
+	assert.Equal(t, audit.DecisionSystem, events[0].Decision)
+	assert.Equal(t, []audit.Change{
+		{Key: "customer", Before: []string{"culverz", "culvers"}, After: []string{"culvers"}},
+	}, events[0].Changes)
+	assert.NoError(t, auditLog.Verify())
+}
+
+func TestJanitor_Start(t *testing.T) {
+	disabled := NewJanitor(time.Hour, 0, map[string]time.Duration{"p1": 0}, nil)
+	assert.NoError(t, disabled.Start())
+	assert.Nil(t, disabled.cancel)
+
+	j := NewJanitor(time.Hour, 0, map[string]time.Duration{"p1": time.Hour}, nil)
+	require.NoError(t, impl.Init("", t.TempDir()))
+	assert.NoError(t, j.Start())
+	assert.Error(t, j.Start())
+	done := j.done
+	j.Stop()
+	// the sweep has stopped once Stop returns
+	select {
+	case <-done:
+	default:
+		assert.Fail(t, "the sweep is still running")
+	}
+	j.Stop()
+}
@@ -7,7 +7,9 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -15,13 +17,27 @@ import (
 	"github.com/atomix/dazl"
 	"github.com/open-edge-platform/orch-library/go/pkg/northbound"
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/grpc"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/policy"
 	"github.com/open-edge-platform/orch-metadata-broker/internal/rest"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/tlsconfig"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var log = dazl.GetPackageLogger()
 
+// DefaultAuthzCacheTTL is how long an authorization decision is reused for the same caller by default.
+const DefaultAuthzCacheTTL = 5 * time.Second
+
+// Default rotation of the audit log.
+const (
+	DefaultAuditMaxSize  = audit.DefaultMaxSize
+	DefaultAuditMaxFiles = audit.DefaultMaxFiles
+)
+
 // Config is a manager configuration
 type Config struct {
 	CAPath             string
@@ -34,7 +50,26 @@ type Config struct {
 	AllowedCorsOrigins string
 	BackupFile         string
 	BackupFolder       string
+	StoreBackend       string
 	OpenapiSpecFile    string
+	// ValueTTL expires the values not asserted for that long, zero keeps them forever
+	ValueTTL time.Duration
+	// ProjectValueTTLs overrides ValueTTL per project
+	ProjectValueTTLs map[string]time.Duration
+	JanitorInterval  time.Duration
+	// RequireClientCert enables mutual TLS on the gRPC server, clients must present a certificate issued by CAPath
+	RequireClientCert bool
+	// PolicyFile evaluates this Rego policy in process instead of querying the OPA sidecar on OPAPort
+	PolicyFile string
+	// AuthzCacheTTL reuses the authorization decisions of a caller for that long, zero disables the cache
+	AuthzCacheTTL time.Duration
+	// AuditLog records the changes to the metadata in this file, empty disables the audit
+	AuditLog string
+	// AuditMaxSize rotates the audit log once it reaches that many bytes, keeping AuditMaxFiles files
+	AuditMaxSize  int64
+	AuditMaxFiles int
+	// MetricsPort serves the Prometheus metrics, it must not be exposed outside of the cluster
+	MetricsPort int
 }
 
 // Manager single point of entry for the provisioner
@@ -42,6 +77,10 @@ type Manager struct {
 	Config Config
 	doneCh chan bool
 	wg     *sync.WaitGroup
+	// certs serves the TLS certificates of the gRPC server and its gateway, nil in plaintext
+	certs *tlsconfig.Reloader
+	// audit records the changes to the metadata, nil when disabled
+	audit *audit.Log
 }
 
 // NewManager initializes the application manager
@@ -61,9 +100,26 @@ func (m *Manager) Run() {
 }
 
 func (m *Manager) Start() error {
-	err := impl.Init(m.Config.BackupFile, m.Config.BackupFolder)
+	if err := m.loadCerts(); err != nil {
+		return err
+	}
+	if m.Config.AuditLog != "" {
+		auditLog, err := audit.Open(m.Config.AuditLog, m.Config.AuditMaxSize, m.Config.AuditMaxFiles)
+		if err != nil {
+			return err
+		}
+		if err := auditLog.Verify(); err != nil {
+			log.Errorf("Audit log %s: %v", m.Config.AuditLog, err)
+		}
+		log.Infof("Recording the changes to the metadata in %s", m.Config.AuditLog)
+		m.audit = auditLog
+		defer func() { _ = auditLog.Close() }()
+	}
+
+	// a new installation without any backup starts from an empty store, any error is fatal
+	err := impl.InitWithBackend(m.Config.StoreBackend, m.Config.BackupFile, m.Config.BackupFolder)
 	if err != nil {
-		log.Info("unable to initialize data store from backup %v assuming new installation\n", err)
+		return fmt.Errorf("unable to initialize the data store from %s: %w", m.Config.BackupFolder, err)
 	}
 
 	m.wg.Add(1)
@@ -80,6 +136,13 @@ func (m *Manager) Start() error {
 		}
 	}()
 
+	m.wg.Add(1)
+	go func() {
+		if err = m.startMetricsServer(); err != nil {
+			log.Fatalf("cannot-start-metrics-server", err.Error())
+		}
+	}()
+
 	log.Info("Subscribing to Tenant Manager")
 
 	tenancyHook := NewTenancyHook()
@@ -88,10 +151,41 @@ func (m *Manager) Start() error {
 	}
 	defer tenancyHook.Unsubscribe()
 
+	janitor := NewJanitor(m.Config.JanitorInterval, m.Config.ValueTTL, m.Config.ProjectValueTTLs, m.audit)
+	if err = janitor.Start(); err != nil {
+		log.Errorf("Unable to start the janitor: %v", err)
+	}
+	defer janitor.Stop()
+
 	m.wg.Wait()
 	return nil
 }
 
+// loadCerts loads the TLS certificates when configured, they are then reloaded on rotation.
+func (m *Manager) loadCerts() error {
+	files := tlsconfig.Files{
+		CAPath:            m.Config.CAPath,
+		CertPath:          m.Config.CertPath,
+		KeyPath:           m.Config.KeyPath,
+		RequireClientCert: m.Config.RequireClientCert,
+	}
+	if !files.Enabled() {
+		if m.Config.RequireClientCert {
+			return fmt.Errorf("mutual TLS requires -certPath and -keyPath")
+		}
+		log.Info("TLS not enabled, the gRPC server listens in plaintext")
+		return nil
+	}
+	certs, err := tlsconfig.NewReloader(files)
+	if err != nil {
+		return err
+	}
+	log.Infof("TLS enabled with %s (mutual TLS: %v)", files.CertPath, files.RequireClientCert)
+	m.certs = certs
+	go certs.Watch(tlsconfig.DefaultReloadInterval, m.doneCh)
+	return nil
+}
+
 const OIDCServerURL = "OIDC_SERVER_URL"
 
 // startNorthboundServer starts the northbound gRPC server
@@ -111,25 +205,29 @@ func (m *Manager) startNorthboundServer() error {
 
 	s := northbound.NewServer(serverConfig)
 
-	serverAddr := fmt.Sprintf("http://localhost:%d", m.Config.OPAPort)
-
 	var opaClient openpolicyagent.ClientWithResponsesInterface
-	var err error
+	var decisions *grpc.DecisionCache
 	if serverConfig.SecurityCfg.AuthorizationEnabled {
-		opaClient, err = openpolicyagent.NewClientWithResponses(serverAddr)
-		if err != nil {
-			log.Fatalf("OPA server cannot be created %v", err)
+		if m.Config.AuthzCacheTTL > 0 {
+			decisions = grpc.NewDecisionCache(m.Config.AuthzCacheTTL)
 		}
+		opaClient = m.newOpaClient(decisions)
 	}
 
-	s.AddService(grpc.NewService(opaClient))
+	s.AddService(grpc.NewService(opaClient, decisions, m.audit))
+
+	// the certificates are served by the reloader rather than loaded once by the server
+	var opts []grpclib.ServerOption
+	if m.certs != nil {
+		opts = append(opts, grpclib.Creds(credentials.NewTLS(m.certs.ServerConfig())))
+	}
 
 	doneCh := make(chan error)
 	go func() {
 		err := s.Serve(func(started string) {
 			log.Info("Started NBI on ", started)
 			close(doneCh)
-		})
+		}, opts...)
 		if err != nil {
 			doneCh <- err
 		}
@@ -138,8 +236,36 @@ func (m *Manager) startNorthboundServer() error {
 	return <-doneCh
 }
 
+// newOpaClient returns the in-process policy engine when a policy file is configured, the
+// client of the OPA sidecar otherwise. The cached decisions are dropped when the engine
+// reloads the policy, with the sidecar they expire with their TTL.
+func (m *Manager) newOpaClient(decisions *grpc.DecisionCache) openpolicyagent.ClientWithResponsesInterface {
+	if m.Config.PolicyFile != "" {
+		engine, err := policy.NewEngine(m.Config.PolicyFile)
+		if err != nil {
+			log.Fatalf("Policy %s cannot be loaded %v", m.Config.PolicyFile, err)
+		}
+		log.Infof("Authorization evaluated in process with the policy %s", m.Config.PolicyFile)
+		if decisions != nil {
+			engine.OnReload(decisions.Invalidate)
+		}
+		go engine.Watch(policy.DefaultReloadInterval, m.doneCh)
+		return engine
+	}
+	serverAddr := fmt.Sprintf("http://localhost:%d", m.Config.OPAPort)
+	opaClient, err := openpolicyagent.NewClientWithResponses(serverAddr)
+	if err != nil {
+		log.Fatalf("OPA server cannot be created %v", err)
+	}
+	return opaClient
+}
+
 func (m *Manager) startRestServer() error {
-	s := rest.NewServer(m.Config.RestPort, m.Config.GRPCPort, m.Config.BasePath, m.Config.AllowedCorsOrigins, m.Config.OpenapiSpecFile)
+	var creds credentials.TransportCredentials
+	if m.certs != nil {
+		creds = credentials.NewTLS(m.certs.ClientConfig())
+	}
+	s := rest.NewServer(m.Config.RestPort, m.Config.GRPCPort, m.Config.BasePath, m.Config.AllowedCorsOrigins, m.Config.OpenapiSpecFile, creds)
 	// start server
 	log.Infow("Starting REST proxy Server", dazl.Int("address", m.Config.RestPort))
 	err := s.ListenAndServe()
@@ -160,3 +286,23 @@ func (m *Manager) startRestServer() error {
 	m.wg.Done()
 	return nil
 }
+
+func (m *Manager) startMetricsServer() error {
+	s := rest.NewMetricsServer(m.Config.MetricsPort)
+	log.Infow("Starting metrics Server", dazl.Int("address", m.Config.MetricsPort))
+	go func() {
+		<-m.doneCh
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.Shutdown(ctx); err != nil {
+			log.Error("Cannot stop metrics server")
+		}
+	}()
+	err := s.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	log.Info("Metrics server stopped")
+	m.wg.Done()
+	return nil
+}
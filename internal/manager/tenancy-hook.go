@@ -102,7 +102,7 @@ func (h *metadataHandler) handleProjectDeleted(event tenancy.Event) error {
 	projectID := event.ResourceID.String()
 	log.Infof("Deleting metadata for project %s (%s)", event.ResourceName, projectID)
 
-	if err := impl.DeleteProject(&projectID); err != nil {
+	if err := impl.DeleteProject(&projectID, nil); err != nil {
 		return fmt.Errorf("delete project %s metadata: %w", event.ResourceName, err)
 	}
 
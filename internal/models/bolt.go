@@ -0,0 +1,381 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltFileName is the name of the bbolt database created in the persist folder.
+const BoltFileName = "metadata.db"
+
+var (
+	boltMetaBucket    = []byte("meta")
+	boltKeysBucket    = []byte("keys")
+	boltSchemasBucket = []byte("schemas")
+	boltDisplayBucket = []byte("display")
+	boltVersionKey    = []byte("version")
+	boltRevisionKey   = []byte("revision")
+	boltProfileKey    = []byte("profile")
+)
+
+// boltValue is the record stored for each value of a key.
+type boltValue struct {
+	Position int         `json:"position"`
+	Owners   []string    `json:"owners,omitempty"`
+	Sources  []string    `json:"sources,omitempty"`
+	Times    *ValueTimes `json:"times,omitempty"`
+	Display  string      `json:"display,omitempty"`
+}
+
+// BoltStore keeps the metadata in a single bbolt file.
+// Each project is a top level bucket holding a "meta" bucket for the store
+// attributes and a "keys" bucket with one nested bucket per key,
+// whose entries are the values of that key. The schemas of the keys are held
+// in a "schemas" bucket, and the display names of the keys in a "display" bucket.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (or creates) the bbolt database at fileName.
+func NewBoltStore(fileName string) (*BoltStore, error) {
+	db, err := bolt.Open(fileName, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		log.Errorf("Error while opening bbolt database %s: %v", fileName, err)
+		return nil, err
+	}
+	log.Infof("Using bbolt database %s", fileName)
+	return &BoltStore{db: db}, nil
+}
+
+func (b *BoltStore) Load(projectId string) (*MetadataStoreV1, error) {
+	m := &MetadataStoreV1{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		project := tx.Bucket([]byte(projectId))
+		if project == nil {
+			return nil
+		}
+		if meta := project.Bucket(boltMetaBucket); meta != nil {
+			m.Version = string(meta.Get(boltVersionKey))
+			if revision := meta.Get(boltRevisionKey); len(revision) == 8 {
+				m.Revision = binary.BigEndian.Uint64(revision)
+			}
+			m.Profile = string(meta.Get(boltProfileKey))
+		}
+		if schemas := project.Bucket(boltSchemasBucket); schemas != nil {
+			err := schemas.ForEach(func(_, data []byte) error {
+				var schema KeySchema
+				if err := json.Unmarshal(data, &schema); err != nil {
+					return err
+				}
+				m.Schemas = append(m.Schemas, schema)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		keys := project.Bucket(boltKeysBucket)
+		if keys == nil {
+			return nil
+		}
+
+		type position struct {
+			key Key
+			pos uint64
+		}
+		var loaded []position
+		err := keys.ForEachBucket(func(name []byte) error {
+			bucket := keys.Bucket(name)
+			key, err := loadBoltKey(string(name), bucket)
+			if err != nil {
+				return err
+			}
+			loaded = append(loaded, position{key: key, pos: bucket.Sequence()})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		sort.SliceStable(loaded, func(i, j int) bool { return loaded[i].pos < loaded[j].pos })
+		display := project.Bucket(boltDisplayBucket)
+		for _, l := range loaded {
+			if display != nil {
+				l.key.setDisplayName(string(display.Get([]byte(l.key.Name))))
+			}
+			m.Keys = append(m.Keys, l.key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func loadBoltKey(name string, bucket *bolt.Bucket) (Key, error) {
+	key := Key{Name: name, Values: []string{}}
+	positions := map[string]int{}
+	err := bucket.ForEach(func(v, data []byte) error {
+		var record boltValue
+		if err := json.Unmarshal(data, &record); err != nil {
+			return err
+		}
+		key.Values = append(key.Values, string(v))
+		positions[string(v)] = record.Position
+		if len(record.Owners) > 0 {
+			if key.Owners == nil {
+				key.Owners = map[string][]string{}
+			}
+			key.Owners[string(v)] = record.Owners
+		}
+		if len(record.Sources) > 0 {
+			if key.Sources == nil {
+				key.Sources = map[string][]string{}
+			}
+			key.Sources[string(v)] = record.Sources
+		}
+		if record.Times != nil {
+			if key.Times == nil {
+				key.Times = map[string]ValueTimes{}
+			}
+			key.Times[string(v)] = *record.Times
+		}
+		key.setDisplayValue(string(v), record.Display)
+		return nil
+	})
+	sort.SliceStable(key.Values, func(i, j int) bool {
+		return positions[key.Values[i]] < positions[key.Values[j]]
+	})
+	return key, err
+}
+
+// Save updates the project in a single transaction, only the keys and values
+// that changed are written.
+func (b *BoltStore) Save(projectId string, data *MetadataStoreV1) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		project, err := tx.CreateBucketIfNotExists([]byte(projectId))
+		if err != nil {
+			return err
+		}
+		meta, err := project.CreateBucketIfNotExists(boltMetaBucket)
+		if err != nil {
+			return err
+		}
+		if err := meta.Put(boltVersionKey, []byte(data.Version)); err != nil {
+			return err
+		}
+		if err := meta.Put(boltRevisionKey, binary.BigEndian.AppendUint64(nil, data.Revision)); err != nil {
+			return err
+		}
+		if data.Profile == "" {
+			err = meta.Delete(boltProfileKey)
+		} else {
+			err = meta.Put(boltProfileKey, []byte(data.Profile))
+		}
+		if err != nil {
+			return err
+		}
+		if err := saveBoltSchemas(project, data.Schemas); err != nil {
+			return err
+		}
+		if err := saveBoltDisplayNames(project, data.Keys); err != nil {
+			return err
+		}
+		keys, err := project.CreateBucketIfNotExists(boltKeysBucket)
+		if err != nil {
+			return err
+		}
+
+		wanted := make(map[string]struct{}, len(data.Keys))
+		for i, k := range data.Keys {
+			wanted[k.Name] = struct{}{}
+			if err := saveBoltKey(keys, i, k); err != nil {
+				return err
+			}
+		}
+
+		// drop the keys that are no longer present
+		var stale [][]byte
+		err = keys.ForEachBucket(func(name []byte) error {
+			if _, ok := wanted[string(name)]; !ok {
+				stale = append(stale, name)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range stale {
+			if err := keys.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func saveBoltKey(keys *bolt.Bucket, position int, k Key) error {
+	bucket, err := keys.CreateBucketIfNotExists([]byte(k.Name))
+	if err != nil {
+		return err
+	}
+	if err := bucket.SetSequence(uint64(position)); err != nil {
+		return err
+	}
+
+	wanted := make(map[string]struct{}, len(k.Values))
+	for i, v := range k.Values {
+		wanted[v] = struct{}{}
+		value := boltValue{Position: i, Owners: k.Owners[v], Sources: k.Sources[v], Display: k.DisplayValues[v]}
+		if times, ok := k.Times[v]; ok {
+			value.Times = &times
+		}
+		record, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if current := bucket.Get([]byte(v)); current != nil && string(current) == string(record) {
+			continue
+		}
+		if err := bucket.Put([]byte(v), record); err != nil {
+			return err
+		}
+	}
+
+	var stale [][]byte
+	err = bucket.ForEach(func(v, _ []byte) error {
+		if _, ok := wanted[string(v)]; !ok {
+			stale = append(stale, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, v := range stale {
+		if err := bucket.Delete(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveBoltDisplayNames records the display names of the keys that have one.
+func saveBoltDisplayNames(project *bolt.Bucket, keys []Key) error {
+	display, err := project.CreateBucketIfNotExists(boltDisplayBucket)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if k.Display != "" {
+			wanted[k.Name] = k.Display
+		}
+	}
+
+	var stale [][]byte
+	err = display.ForEach(func(name, current []byte) error {
+		if wanted[string(name)] == "" {
+			stale = append(stale, name)
+		} else if wanted[string(name)] == string(current) {
+			delete(wanted, string(name))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range stale {
+		if err := display.Delete(name); err != nil {
+			return err
+		}
+	}
+	for name, value := range wanted {
+		if err := display.Put([]byte(name), []byte(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveBoltSchemas replaces the schemas of the project, they are only written when they change.
+func saveBoltSchemas(project *bolt.Bucket, schemas []KeySchema) error {
+	records := make(map[string][]byte, len(schemas))
+	for _, schema := range schemas {
+		record, err := json.Marshal(schema)
+		if err != nil {
+			return err
+		}
+		records[schema.Key] = record
+	}
+
+	bucket := project.Bucket(boltSchemasBucket)
+	if bucket != nil {
+		n, unchanged := 0, true
+		err := bucket.ForEach(func(k, v []byte) error {
+			n++
+			unchanged = unchanged && string(records[string(k)]) == string(v)
+			return nil
+		})
+		if err != nil || (unchanged && n == len(records)) {
+			return err
+		}
+		if err := project.DeleteBucket(boltSchemasBucket); err != nil {
+			return err
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	bucket, err := project.CreateBucket(boltSchemasBucket)
+	if err != nil {
+		return err
+	}
+	for key, record := range records {
+		if err := bucket.Put([]byte(key), record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BoltStore) DeleteProject(projectId string) error {
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket([]byte(projectId))
+	})
+	if errors.Is(err, bolt.ErrBucketNotFound) {
+		log.Debugf("Project %s doesn't exist", projectId)
+		return nil
+	}
+	if err != nil {
+		log.Errorf("Failed to delete project: %v", err)
+		return err
+	}
+	log.Infof("Successfully deleted project %s", projectId)
+	return nil
+}
+
+func (b *BoltStore) Projects() ([]string, error) {
+	var projects []string
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			projects = append(projects, string(name))
+			return nil
+		})
+	})
+	return projects, err
+}
+
+func (b *BoltStore) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,230 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBoltStore(t *testing.T) *BoltStore {
+	t.Helper()
+	b, err := NewBoltStore(path.Join(t.TempDir(), BoltFileName))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = b.Close() })
+	return b
+}
+
+func TestBoltStore_SaveLoad(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	seen := created.Add(36 * time.Hour)
+	tests := []struct {
+		name  string
+		saves []*MetadataStoreV1
+		want  *MetadataStoreV1
+	}{
+		{
+			"single-save",
+			[]*MetadataStoreV1{expectedMetadataV1},
+			expectedMetadataV1,
+		},
+		{
+			"preserves-order",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "zone", Values: []string{"west", "east"}},
+					{Name: "app", Values: []string{"b", "a"}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "zone", Values: []string{"west", "east"}},
+				{Name: "app", Values: []string{"b", "a"}},
+			}}},
+		},
+		{
+			"update",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}},
+					{Name: "gone", Values: []string{"x"}},
+				}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "new", Values: []string{"n"}},
+					{Name: "foo", Values: []string{"rab", "baz"}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "new", Values: []string{"n"}},
+				{Name: "foo", Values: []string{"rab", "baz"}},
+			}}},
+		},
+		{
+			"revision",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1", Revision: 41}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}},
+				{VersionedStore{Version: "v1", Revision: 42}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"rab"}}}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1", Revision: 42}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"rab"}}}}},
+		},
+		{
+			"owners-and-sources",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o1", "o2"}, "rab": {"o1"}}},
+				}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o2"}}, Sources: map[string][]string{"rab": {"app-orch", "edge-infra"}}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o2"}}, Sources: map[string][]string{"rab": {"app-orch", "edge-infra"}}},
+			}}},
+		},
+		{
+			"times",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Times: map[string]ValueTimes{"bar": {CreatedAt: created, LastSeenAt: seen}}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Times: map[string]ValueTimes{"bar": {CreatedAt: created, LastSeenAt: seen}}},
+			}}},
+		},
+		{
+			"schemas",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "foo", AllowedValues: []string{"bar", "rab"}}, {Key: "size", MaxValues: 2}},
+				}},
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "env", Pattern: "dev|prod", MaxValues: 1}, {Key: "foo", AllowedValues: []string{"bar", "rab"}}},
+				}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{
+				Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+				Schemas: []KeySchema{{Key: "env", Pattern: "dev|prod", MaxValues: 1}, {Key: "foo", AllowedValues: []string{"bar", "rab"}}},
+			}},
+		},
+		{
+			"display",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Display: "Foo", DisplayValues: map[string]string{"rab": "RAB"}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Display: "Foo", DisplayValues: map[string]string{"rab": "RAB"}},
+			}}},
+		},
+		{
+			"profile",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}, Profile: ProfileExtended}},
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "foo", Profile: ProfileK8sLabel}},
+				}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{
+				Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+				Schemas: []KeySchema{{Key: "foo", Profile: ProfileK8sLabel}},
+			}},
+		},
+		{
+			"empty-key",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{}}}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{}}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBoltStore(t)
+			for _, s := range tt.saves {
+				require.NoError(t, b.Save(projectId, s))
+			}
+
+			got, err := b.Load(projectId)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBoltStore_LoadMissingProject(t *testing.T) {
+	b := newTestBoltStore(t)
+
+	got, err := b.Load("missing")
+	assert.NoError(t, err)
+	assert.Equal(t, &MetadataStoreV1{}, got)
+}
+
+func TestBoltStore_Projects(t *testing.T) {
+	b := newTestBoltStore(t)
+	require.NoError(t, b.Save("p1", expectedMetadataV1))
+	require.NoError(t, b.Save("p2", expectedMetadataV1))
+
+	projects, err := b.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2"}, projects)
+
+	assert.NoError(t, b.DeleteProject("p1"))
+	assert.NoError(t, b.DeleteProject("missing"))
+
+	projects, err = b.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p2"}, projects)
+}
+
+func TestBoltStore_Reopen(t *testing.T) {
+	fileName := path.Join(t.TempDir(), BoltFileName)
+	b, err := NewBoltStore(fileName)
+	require.NoError(t, err)
+	require.NoError(t, b.Save(projectId, expectedMetadataV1))
+	require.NoError(t, b.Close())
+
+	b, err = NewBoltStore(fileName)
+	require.NoError(t, err)
+	defer func() { _ = b.Close() }()
+
+	got, err := b.Load(projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+}
+
+func TestMigrateFiles(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, os.WriteFile(getFilename(folder, "p1"), []byte(jsonmetadataV1), 0644))
+	require.NoError(t, os.WriteFile(getFilename(folder, "p2"), []byte(`{"version":"v1","keys":[]}`), 0644))
+
+	b := newTestBoltStore(t)
+	require.NoError(t, MigrateFiles(folder, b))
+
+	got, err := b.Load("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+
+	projects, err := b.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2"}, projects)
+
+	// the files are kept aside and not imported again
+	_, err = os.Stat(getFilename(folder, "p1") + ".migrated")
+	assert.NoError(t, err)
+	projects, err = NewFileStore(folder).Projects()
+	assert.NoError(t, err)
+	assert.Empty(t, projects)
+}
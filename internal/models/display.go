@@ -0,0 +1,53 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import "strings"
+
+// Normalize returns the form in which keys and values are stored and matched, so that
+// matching is case-insensitive. The original casing is kept as their display form.
+func Normalize(s string) string {
+	return strings.ToLower(s)
+}
+
+// DisplayName returns the name of the key as it was first written.
+func (k *Key) DisplayName() string {
+	if k.Display != "" {
+		return k.Display
+	}
+	return k.Name
+}
+
+// DisplayValue returns the value as it was first written.
+func (k *Key) DisplayValue(v string) string {
+	if display, ok := k.DisplayValues[v]; ok {
+		return display
+	}
+	return v
+}
+
+// setDisplayName records how the name of the key is displayed.
+func (k *Key) setDisplayName(display string) {
+	k.Display = ""
+	if display != k.Name {
+		k.Display = display
+	}
+}
+
+// setDisplayValue records how the value is displayed.
+func (k *Key) setDisplayValue(v, display string) {
+	if display == v || display == "" {
+		delete(k.DisplayValues, v)
+		if len(k.DisplayValues) == 0 {
+			k.DisplayValues = nil
+		}
+		return
+	}
+	if k.DisplayValues == nil {
+		k.DisplayValues = map[string]string{}
+	}
+	k.DisplayValues[v] = display
+}
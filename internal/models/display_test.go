@@ -0,0 +1,53 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey_display(t *testing.T) {
+	k := Key{Name: "customer", Values: []string{"acme"}}
+	assert.Equal(t, "customer", k.DisplayName())
+	assert.Equal(t, "acme", k.DisplayValue("acme"))
+
+	k.setDisplayName("Customer")
+	k.setDisplayValue("acme", "ACME")
+	assert.Equal(t, "Customer", k.DisplayName())
+	assert.Equal(t, "ACME", k.DisplayValue("acme"))
+
+	// the stored form is not kept twice
+	k.setDisplayName("customer")
+	k.setDisplayValue("acme", "acme")
+	assert.Equal(t, Key{Name: "customer", Values: []string{"acme"}}, k)
+}
+
+func TestMetadata_displayFirstWriterWins(t *testing.T) {
+	m := &Metadata{}
+	_, _, err := m.createOrUpdate(&pb.Metadata{Key: "Customer", Value: "ACME-Corp"})
+	require.NoError(t, err)
+	_, created, err := m.createOrUpdate(&pb.Metadata{Key: "CUSTOMER", Value: "acme-corp"})
+	require.NoError(t, err)
+	assert.False(t, created)
+	_, _, err = m.createOrUpdate(&pb.Metadata{Key: "customer", Value: "Culvers"})
+	require.NoError(t, err)
+
+	got, err := m.GetKeyValues()
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "customer", got[0].Key)
+	assert.Equal(t, "Customer", got[0].DisplayKey)
+	assert.Equal(t, []string{"acme-corp", "culvers"}, got[0].Values)
+	assert.Equal(t, map[string]string{"acme-corp": "ACME-Corp", "culvers": "Culvers"}, got[0].DisplayValues)
+
+	// the display form goes away with its value
+	require.NoError(t, m.delete(&pb.Metadata{Key: "customer", Value: "ACME-CORP"}))
+	assert.Equal(t, map[string]string{"culvers": "Culvers"}, m.Keys[0].DisplayValues)
+}
@@ -0,0 +1,83 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+)
+
+// SeenResolution is how often re-asserting a value refreshes its last seen time,
+// so that clients re-asserting their values in a loop don't rewrite the project every time.
+const SeenResolution = time.Minute
+
+// now returns the current time as stored in the value times, it is replaced in the tests.
+var now = func() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// ValueTimes records when a value was first stored and when it was last asserted.
+type ValueTimes struct {
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// SeenSince reports whether the value was asserted at or after t.
+func (k *Key) SeenSince(v string, t time.Time) bool {
+	times, ok := k.Times[v]
+	return ok && !times.LastSeenAt.Before(t)
+}
+
+// fresh reports whether asserting the value at t would leave its last seen time as is.
+func (k *Key) fresh(v string, t time.Time) bool {
+	times, ok := k.Times[v]
+	return ok && t.Sub(times.LastSeenAt) < SeenResolution
+}
+
+// seen records that the value was asserted at t, it is created at t if it has no times yet.
+func (k *Key) seen(v string, t time.Time) {
+	if k.fresh(v, t) {
+		return
+	}
+	times, ok := k.Times[v]
+	if !ok {
+		times.CreatedAt = t
+	}
+	times.LastSeenAt = t
+	if k.Times == nil {
+		k.Times = map[string]ValueTimes{}
+	}
+	k.Times[v] = times
+}
+
+// Expire removes the values that have not been asserted since before, returning them.
+// Values referenced by an owner are kept, they go away with their last reference.
+// Values stored before the times were recorded are considered seen now. Keys left without
+// values are removed.
+func (m *Metadata) Expire(before time.Time) []*pb.Metadata {
+	t := now()
+	var expired []*pb.Metadata
+	for i := range m.Keys {
+		key := &m.Keys[i]
+		// the values are shared with the in-memory copy, don't filter in place
+		kept := key.Values[:0:0]
+		for _, v := range key.Values {
+			if _, ok := key.Times[v]; !ok {
+				key.seen(v, t)
+			}
+			if key.RefCount(v) > 0 || key.SeenSince(v, before) {
+				kept = append(kept, v)
+				continue
+			}
+			key.forget(v)
+			expired = append(expired, &pb.Metadata{Key: key.Name, Value: v})
+		}
+		key.Values = kept
+	}
+	m.dropEmptyKeys()
+	return expired
+}
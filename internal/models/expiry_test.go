@@ -0,0 +1,111 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withoutTimes returns a copy of the keys without their value times, for the tests not about them.
+func withoutTimes(keys []Key) []Key {
+	if keys == nil {
+		return nil
+	}
+	c := make([]Key, len(keys))
+	for i, k := range keys {
+		k.Times = nil
+		c[i] = k
+	}
+	return c
+}
+
+// setClock makes now return the time of the clock until the end of the test.
+func setClock(t *testing.T, clock *time.Time) {
+	saved := now
+	now = func() time.Time { return *clock }
+	t.Cleanup(func() { now = saved })
+}
+
+func TestMetadata_createOrUpdate_Times(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	setClock(t, &clock)
+	created := clock
+
+	m := &Metadata{}
+	m.createOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	assert.Equal(t, ValueTimes{CreatedAt: created, LastSeenAt: created}, m.Keys[0].Times["bar"])
+
+	// re-asserting within the resolution doesn't change anything
+	clock = clock.Add(SeenResolution - time.Second)
+	m.createOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	assert.Equal(t, ValueTimes{CreatedAt: created, LastSeenAt: created}, m.Keys[0].Times["bar"])
+
+	clock = clock.Add(time.Second)
+	m.createOrUpdate(&pb.Metadata{Key: "Foo", Value: "Bar"})
+	assert.Equal(t, ValueTimes{CreatedAt: created, LastSeenAt: clock}, m.Keys[0].Times["bar"])
+
+	// the times go away with the value
+	m.createOrUpdate(&pb.Metadata{Key: "foo", Value: "baz"})
+	require.NoError(t, m.delete(&pb.Metadata{Key: "foo", Value: "bar"}))
+	assert.NotContains(t, m.Keys[0].Times, "bar")
+}
+
+func TestMetadata_Expire(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	setClock(t, &clock)
+	old, recent := clock.Add(-48*time.Hour), clock.Add(-time.Hour)
+
+	m := &Metadata{Keys: []Key{
+		{
+			Name:   "foo",
+			Values: []string{"stale", "recent", "referenced", "legacy"},
+			Owners: map[string][]string{"referenced": {"o1"}},
+			Times: map[string]ValueTimes{
+				"stale":      {CreatedAt: old, LastSeenAt: old},
+				"recent":     {CreatedAt: old, LastSeenAt: recent},
+				"referenced": {CreatedAt: old, LastSeenAt: old},
+			},
+		},
+		{Name: "typo", Values: []string{"culverz"}, Times: map[string]ValueTimes{"culverz": {CreatedAt: old, LastSeenAt: old}}},
+	}}
+	values := m.Keys[0].Values
+
+	expired := m.Expire(clock.Add(-24 * time.Hour))
+	assert.Equal(t, []*pb.Metadata{{Key: "foo", Value: "stale"}, {Key: "typo", Value: "culverz"}}, expired)
+	assert.Equal(t, []string{"recent", "referenced", "legacy"}, m.Keys[0].Values)
+	// the key goes away with its last value
+	assert.Len(t, m.Keys, 1)
+	// values without times are considered seen now
+	assert.Equal(t, ValueTimes{CreatedAt: clock, LastSeenAt: clock}, m.Keys[0].Times["legacy"])
+	assert.NotContains(t, m.Keys[0].Times, "stale")
+	// the values are not modified in place
+	assert.Equal(t, []string{"stale", "recent", "referenced", "legacy"}, values)
+
+	assert.Empty(t, m.Expire(clock.Add(-24*time.Hour)))
+}
+
+func TestMemoryStore_Current(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	setClock(t, &clock)
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	_, err = m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	})
+	require.NoError(t, err)
+	assert.True(t, m.Current(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+	assert.False(t, m.Current(projectId, &pb.Metadata{Key: "foo", Value: "rab"}))
+
+	clock = clock.Add(SeenResolution)
+	assert.False(t, m.Current(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+}
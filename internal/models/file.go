@@ -0,0 +1,169 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const checksumPrefix = "sha256:"
+
+// fileEnvelope is the on-disk format of a project file: the metadata
+// together with the checksum of its JSON encoding.
+type fileEnvelope struct {
+	Checksum string          `json:"checksum"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
+
+func encodeMetadataV1(data *MetadataStoreV1) ([]byte, error) {
+	bytes, err := data.GetJson()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fileEnvelope{Checksum: checksum(bytes), Metadata: bytes})
+}
+
+// decodeMetadataV1 reads a project file, both in the envelope format and in
+// the plain format written by earlier releases. Empty files hold no metadata.
+// The keys left without values by earlier releases are dropped.
+func decodeMetadataV1(bytes []byte) (*MetadataStoreV1, error) {
+	m := &MetadataStoreV1{}
+	if len(bytes) == 0 {
+		return m, nil
+	}
+
+	var envelope fileEnvelope
+	if err := json.Unmarshal(bytes, &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Checksum == "" && envelope.Metadata == nil {
+		// plain file, not written through the envelope yet
+		if err := json.Unmarshal(bytes, m); err != nil {
+			return nil, err
+		}
+		m.dropEmptyKeys()
+		return m, nil
+	}
+
+	if got := checksum(envelope.Metadata); got != envelope.Checksum {
+		return nil, fmt.Errorf("checksum mismatch: expected %s, got %s", envelope.Checksum, got)
+	}
+	if err := json.Unmarshal(envelope.Metadata, m); err != nil {
+		return nil, err
+	}
+	m.dropEmptyKeys()
+	return m, nil
+}
+
+// writeFileAtomic replaces fileName with data so that, even on a crash,
+// the file holds either the previous or the new content: the data is written
+// and synced to a temporary file in the same folder, which is then renamed over fileName.
+func writeFileAtomic(fileName string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(fileName)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(fileName)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), fileName); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir persists the directory entries, making a rename durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = d.Close() }()
+	return d.Sync()
+}
+
+// Suffixes of the corrupted project files, followed by the time they were quarantined: they are
+// renamed as discarded once their project is deleted.
+const (
+	quarantineSuffix = ".corrupt-"
+	discardedSuffix  = ".discarded-"
+)
+
+// quarantine moves a corrupted project file aside, so that it is kept for
+// inspection and never overwritten, and returns the error reported to the callers.
+func quarantine(fileName, projectId string, cause error) error {
+	quarantined := fmt.Sprintf("%s%s%d", fileName, quarantineSuffix, time.Now().Unix())
+	if err := os.Rename(fileName, quarantined); err != nil {
+		log.Errorf("Metadata file %s of project %s is corrupted (%v) and could not be quarantined: %v", fileName, projectId, cause, err)
+		return status.Errorf(codes.DataLoss, "metadata of project %s is corrupted", projectId)
+	}
+	log.Errorf("Metadata file %s of project %s is corrupted (%v), moved to %s", fileName, projectId, cause, quarantined)
+	return status.Errorf(codes.DataLoss, "metadata of project %s is corrupted, moved to %s", projectId, filepath.Base(quarantined))
+}
+
+// quarantined returns the error reported for a project whose file has been quarantined and
+// not replaced since, so that the project keeps failing across restarts rather than being
+// served as empty. It returns nil when the project file exists or was never quarantined.
+func quarantined(fileName, projectId string) error {
+	if _, err := os.Stat(fileName); !errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	files, err := filepath.Glob(fileName + quarantineSuffix + "*")
+	if err != nil || len(files) == 0 {
+		return nil
+	}
+	sort.Strings(files)
+	return status.Errorf(codes.DataLoss, "metadata of project %s is corrupted, moved to %s", projectId, filepath.Base(files[len(files)-1]))
+}
+
+// discardQuarantined renames the quarantined files of a project deleted on purpose, so that they
+// are kept for inspection without reporting the project as corrupted anymore.
+func discardQuarantined(fileName string) error {
+	files, err := filepath.Glob(fileName + quarantineSuffix + "*")
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		discarded := fileName + discardedSuffix + strings.TrimPrefix(f, fileName+quarantineSuffix)
+		if err := os.Rename(f, discarded); err != nil {
+			return err
+		}
+		log.Infof("Corrupted metadata file %s discarded as %s", f, discarded)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSaveMetadataV1_RoundTrip(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, SaveMetadataV1(expectedMetadataV1, folder, projectId))
+	require.NoError(t, SaveMetadataV1(expectedMetadataV1, folder, projectId))
+
+	got, err := LoadMetadataV1(folder, projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+
+	// no temporary file is left behind
+	entries, err := os.ReadDir(folder)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, filepath.Base(getFilename(folder, projectId)), entries[0].Name())
+}
+
+func TestLoadMetadataV1_EmptyKeys(t *testing.T) {
+	folder := t.TempDir()
+	// earlier releases left the keys of the deleted values without values
+	plain := `{"version":"v1","revision":3,"keys":[{"name":"foo","values":[]},{"name":"bar","values":["baz"]},{"name":"qux","values":null}]}`
+	require.NoError(t, os.WriteFile(getFilename(folder, projectId), []byte(plain), 0644))
+
+	got, err := LoadMetadataV1(folder, projectId)
+	require.NoError(t, err)
+	assert.Equal(t, []Key{{Name: "bar", Values: []string{"baz"}}}, got.Keys)
+	assert.Equal(t, uint64(3), got.Revision)
+
+	m, err := NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+	kv, err := m.GetKeyValues(projectId)
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "bar", Values: []string{"baz"}}}, kv)
+}
+
+func TestLoadMetadataV1_Corrupted(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"truncated", jsonmetadataV1[:len(jsonmetadataV1)/2]},
+		{"truncated-envelope", `{"checksum":"sha256:40a72c3a21eaf3b1b55ccc6b5bda00fd544459910b02fea8a7fc285983cf201b","metadata":{"ver`},
+		{"checksum-mismatch", `{"checksum":"sha256:40a72c3a21eaf3b1b55ccc6b5bda00fd544459910b02fea8a7fc285983cf201b",` +
+			`"metadata":{"version":"v1","keys":[{"name":"foo","values":["bar"]}]}}`},
+		{"wrong-type", `{"version":"v1","keys":"foo"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folder := t.TempDir()
+			filename := getFilename(folder, projectId)
+			require.NoError(t, os.WriteFile(filename, []byte(tt.content), 0644))
+
+			got, err := LoadMetadataV1(folder, projectId)
+			assert.Nil(t, got)
+			assert.Equal(t, codes.DataLoss, status.Code(err))
+
+			// the file is moved aside with its content untouched
+			_, err = os.Stat(filename)
+			assert.True(t, os.IsNotExist(err))
+			quarantined, err := filepath.Glob(filename + ".corrupt-*")
+			require.NoError(t, err)
+			require.Len(t, quarantined, 1)
+			data, err := os.ReadFile(quarantined[0])
+			assert.NoError(t, err)
+			assert.Equal(t, tt.content, string(data))
+
+			// the project is still listed, and reported as corrupted until the file is replaced
+			projects, err := NewFileStore(folder).Projects()
+			assert.NoError(t, err)
+			assert.Equal(t, []string{projectId}, projects)
+			_, err = LoadMetadataV1(folder, projectId)
+			assert.Equal(t, codes.DataLoss, status.Code(err))
+			_, err = os.Stat(filename)
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestMemoryStore_Corrupted(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, os.WriteFile(getFilename(folder, "p1"), []byte(jsonmetadataV1), 0644))
+	require.NoError(t, os.WriteFile(getFilename(folder, "p2"), []byte(`{"version":"v1","keys":[`), 0644))
+
+	m, err := NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+
+	got, err := m.Load("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+
+	// the corrupted project is reported rather than served as empty
+	_, err = m.Load("p2")
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	_, err = m.GetKeyValues("p2")
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	_, err = m.Update("p2", func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+
+	// after a restart too
+	m, err = NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+	_, err = m.GetKeyValues("p2")
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+
+	// until it is deleted, its corrupted file is kept aside
+	require.NoError(t, m.DeleteProject("p2"))
+	kv, err := m.GetKeyValues("p2")
+	assert.NoError(t, err)
+	assert.Nil(t, kv)
+	discarded, err := filepath.Glob(getFilename(folder, "p2") + ".discarded-*")
+	require.NoError(t, err)
+	assert.Len(t, discarded, 1)
+
+	m, err = NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+	kv, err = m.GetKeyValues("p2")
+	assert.NoError(t, err)
+	assert.Nil(t, kv)
+	_, err = m.Update("p2", func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	})
+	assert.NoError(t, err)
+}
@@ -12,8 +12,9 @@ import (
 	"io"
 	"os"
 	"path"
+	"regexp"
+	"sort"
 	"strings"
-	"sync"
 
 	"github.com/atomix/dazl"
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
@@ -22,19 +23,121 @@ import (
 )
 
 var log = dazl.GetPackageLogger()
-var lock sync.Mutex
 
 type Key struct {
+	// Name and Values are normalized, matching them is case-insensitive.
 	Name   string   `json:"name"`
 	Values []string `json:"values"`
+	// Display is the name as first written, when it differs from the normalized one.
+	Display string `json:"display,omitempty"`
+	// DisplayValues holds, per value, the value as first written when it differs from the normalized one.
+	DisplayValues map[string]string `json:"display_values,omitempty"`
+	// Owners lists, per value, the owners holding a reference to it (sorted).
+	// Values without any reference are not listed.
+	Owners map[string][]string `json:"owners,omitempty"`
+	// Sources lists, per value, the components that wrote it (sorted).
+	// Values written without a source are not listed.
+	Sources map[string][]string `json:"sources,omitempty"`
+	// Times records, per value, when it was created and last asserted.
+	Times map[string]ValueTimes `json:"times,omitempty"`
 }
 
 func (k *Key) AddValue(v string) {
 	k.Values = append(k.Values, v)
 }
 
+// RefCount returns the number of owners referencing the value.
+func (k *Key) RefCount(v string) int {
+	return len(k.Owners[v])
+}
+
+// HasOwner reports whether the owner holds a reference to the value.
+func (k *Key) HasOwner(v, owner string) bool {
+	return inSet(k.Owners[v], owner)
+}
+
+// HasSource reports whether the value was written by the source.
+func (k *Key) HasSource(v, source string) bool {
+	return inSet(k.Sources[v], source)
+}
+
+// acquire records a reference of owner to the value, reporting whether it is a new one.
+func (k *Key) acquire(v, owner string) bool {
+	var added bool
+	k.Owners, added = addToSet(k.Owners, v, owner)
+	return added
+}
+
+// release drops the reference of owner to the value, reporting whether it held one.
+func (k *Key) release(v, owner string) bool {
+	var removed bool
+	k.Owners, removed = removeFromSet(k.Owners, v, owner)
+	return removed
+}
+
+// addSource records that the value was written by the source.
+func (k *Key) addSource(v, source string) {
+	k.Sources, _ = addToSet(k.Sources, v, source)
+}
+
+// forget drops the references to the value, its sources, its times and its display form.
+func (k *Key) forget(v string) {
+	k.Owners = dropSet(k.Owners, v)
+	k.Sources = dropSet(k.Sources, v)
+	k.setDisplayValue(v, "")
+	delete(k.Times, v)
+	if len(k.Times) == 0 {
+		k.Times = nil
+	}
+}
+
+func inSet(set []string, name string) bool {
+	i := sort.SearchStrings(set, name)
+	return i < len(set) && set[i] == name
+}
+
+// addToSet adds name to the sorted set of the value, the sets are copied on write
+// as they are shared with the in-memory index.
+func addToSet(sets map[string][]string, v, name string) (map[string][]string, bool) {
+	set := sets[v]
+	i := sort.SearchStrings(set, name)
+	if i < len(set) && set[i] == name {
+		return sets, false
+	}
+	if sets == nil {
+		sets = map[string][]string{}
+	}
+	sets[v] = append(set[:i:i], append([]string{name}, set[i:]...)...)
+	return sets, true
+}
+
+func removeFromSet(sets map[string][]string, v, name string) (map[string][]string, bool) {
+	set := sets[v]
+	i := sort.SearchStrings(set, name)
+	if i == len(set) || set[i] != name {
+		return sets, false
+	}
+	if len(set) == 1 {
+		return dropSet(sets, v), true
+	}
+	sets[v] = append(set[:i:i], set[i+1:]...)
+	return sets, true
+}
+
+func dropSet(sets map[string][]string, v string) map[string][]string {
+	delete(sets, v)
+	if len(sets) == 0 {
+		return nil
+	}
+	return sets
+}
+
 type Metadata struct {
 	Keys []Key `json:"keys"`
+	// Schemas constrain the values of some keys (sorted by key).
+	Schemas []KeySchema `json:"schemas,omitempty"`
+	// Profile validates the keys and values without a profile of their own, empty for k8s-label.
+	Profile string `json:"profile,omitempty"`
 }
 
 func (m *Metadata) GetJson() ([]byte, error) {
@@ -46,7 +149,19 @@ func (m *Metadata) GetKeyValues() ([]*pb.StoredMetadata, error) {
 	for i := 0; i < len(m.Keys); i++ {
 		k := m.Keys[i].Name
 		vals := m.Keys[i].Values
-		kv := pb.StoredMetadata{Key: k, Values: vals}
+		kv := pb.StoredMetadata{Key: k, Values: vals, DisplayKey: m.Keys[i].Display}
+		if len(m.Keys[i].DisplayValues) > 0 {
+			kv.DisplayValues = make(map[string]string, len(m.Keys[i].DisplayValues))
+			for v, display := range m.Keys[i].DisplayValues {
+				kv.DisplayValues[v] = display
+			}
+		}
+		if len(m.Keys[i].Owners) > 0 {
+			kv.RefCounts = make(map[string]uint32, len(m.Keys[i].Owners))
+			for v, owners := range m.Keys[i].Owners {
+				kv.RefCounts[v] = uint32(len(owners))
+			}
+		}
 		log.Debugf("Found Metadata entry: {Key: %s, values: %s}", k, vals)
 		keyValues = append(keyValues, &kv)
 	}
@@ -54,14 +169,97 @@ func (m *Metadata) GetKeyValues() ([]*pb.StoredMetadata, error) {
 	return keyValues, nil
 }
 
-func (m *Metadata) createOrUpdate(k *pb.Metadata) {
+// FromSource returns the keys with only the values written by the source,
+// keys without any such value are left out.
+func (m *Metadata) FromSource(source string) *Metadata {
+	source = strings.ToLower(source)
+	filtered := &Metadata{}
+	for _, k := range m.Keys {
+		key := Key{Name: k.Name, Display: k.Display}
+		for _, v := range k.Values {
+			if !k.HasSource(v, source) {
+				continue
+			}
+			key.AddValue(v)
+			key.setDisplayValue(v, k.DisplayValues[v])
+			key.Sources, _ = addToSet(key.Sources, v, source)
+			if owners, ok := k.Owners[v]; ok {
+				if key.Owners == nil {
+					key.Owners = map[string][]string{}
+				}
+				key.Owners[v] = owners
+			}
+		}
+		if len(key.Values) > 0 {
+			filtered.Keys = append(filtered.Keys, key)
+		}
+	}
+	return filtered
+}
+
+// ownerPattern matches the valid owners, as in the v1.Metadata owner rules.
+var ownerPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._:/-]*[A-Za-z0-9])?$`)
+
+// checkOwner fails with InvalidArgument unless the owner is empty or names a valid owner,
+// e.g. app-orch/deployment/123.
+func checkOwner(owner string) error {
+	if owner != "" && (len(owner) > 253 || !ownerPattern.MatchString(owner)) {
+		return status.Errorf(codes.InvalidArgument, "invalid owner %q, it accepts at most 253 letters, digits, dots, underscores, "+
+			"colons, slashes and dashes, starting and ending with a letter or digit", owner)
+	}
+	return nil
+}
+
+// sourcePattern matches the valid sources, as in the v1.Metadata source rules.
+var sourcePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$`)
+
+// ValidSource reports whether the source names a component, e.g. app-orch.
+func ValidSource(source string) bool {
+	return len(source) <= 63 && sourcePattern.MatchString(source)
+}
+
+// createOrUpdate stores the key/value pair, returning the normalized form and
+// whether it was added (false if it was already present). Either way the value is seen now.
+// With an owner, the owner also acquires a reference to the value.
+// Values rejected by the schema of the key, invalid owners and sources fail with InvalidArgument.
+func (m *Metadata) createOrUpdate(k *pb.Metadata) (*pb.Metadata, bool, error) {
+	if err := checkOwner(k.Owner); err != nil {
+		return nil, false, err
+	}
 
-	// make sure that we only store lowercase metadata to avoid confusion
+	// store the normalized metadata to avoid confusion, the first casing written is displayed
 	md := &pb.Metadata{
-		Key:   strings.ToLower(k.Key),
-		Value: strings.ToLower(k.Value),
+		Key:    Normalize(k.Key),
+		Value:  Normalize(k.Value),
+		Owner:  k.Owner,
+		Source: strings.ToLower(k.Source),
+	}
+	if md.Source != "" && !ValidSource(md.Source) {
+		return nil, false, status.Errorf(codes.InvalidArgument, "invalid source %q, it accepts at most 63 letters, digits, dots, "+
+			"underscores and dashes, starting and ending with a letter or digit", k.Source)
+	}
+	if err := m.checkAdd(md.Key, md.Value, k.Value); err != nil {
+		return nil, false, err
 	}
 
+	key, added := m.addValue(md)
+	if added {
+		if len(key.Values) == 1 {
+			key.setDisplayName(k.Key)
+		}
+		key.setDisplayValue(md.Value, k.Value)
+	}
+	key.seen(md.Value, now())
+	if md.Owner != "" && key.acquire(md.Value, md.Owner) {
+		log.Debugf("Owner %s acquired %s=%s", md.Owner, md.Key, md.Value)
+	}
+	if md.Source != "" {
+		key.addSource(md.Value, md.Source)
+	}
+	return md, added, nil
+}
+
+func (m *Metadata) addValue(md *pb.Metadata) (*Key, bool) {
 	for i := 0; i < len(m.Keys); i++ {
 		key := &m.Keys[i]
 		if key.Name == md.Key {
@@ -69,13 +267,13 @@ func (m *Metadata) createOrUpdate(k *pb.Metadata) {
 			for j := 0; j < len(key.Values); j++ {
 				if md.Value == key.Values[j] {
 					// Already exists so just exit quietly
-					return
+					return key, false
 				}
 			}
 			//append value to slice
 			key.AddValue(md.Value)
 			log.Debugf("Adding Value %s\n", md.Value)
-			return
+			return key, true
 		}
 	}
 	log.Debugf("Adding Key %s with Value %s", md.Key, md.Value)
@@ -83,13 +281,21 @@ func (m *Metadata) createOrUpdate(k *pb.Metadata) {
 		Name:   md.Key,
 		Values: []string{md.Value},
 	})
+	return &m.Keys[len(m.Keys)-1], true
 }
 
+// delete removes the key/value pair, and the key with its last value. With an owner, the owner
+// releases its reference instead and the value is only removed once no reference is left;
+// values still referenced can't be removed without an owner. Owners without a reference to
+// the value fail with NotFound.
 func (m *Metadata) delete(k *pb.Metadata) error {
+	if err := checkOwner(k.Owner); err != nil {
+		return err
+	}
 
 	md := &pb.Metadata{
-		Key:   strings.ToLower(k.Key),
-		Value: strings.ToLower(k.Value),
+		Key:   Normalize(k.Key),
+		Value: Normalize(k.Value),
 	}
 
 	for i := 0; i < len(m.Keys); i++ {
@@ -98,19 +304,81 @@ func (m *Metadata) delete(k *pb.Metadata) error {
 			//Key exists so check if value exists
 			for j := 0; j < len(key.Values); j++ {
 				if md.Value == key.Values[j] {
+					if k.Owner != "" {
+						if !key.release(md.Value, k.Owner) {
+							return status.Errorf(codes.NotFound, "%s=%s is not referenced by owner %s", md.Key, md.Value, k.Owner)
+						}
+						if key.RefCount(md.Value) > 0 {
+							// still used by others
+							return nil
+						}
+						log.Debugf("Owner %s released the last reference to %s=%s", k.Owner, md.Key, md.Value)
+					} else if n := key.RefCount(md.Value); n > 0 {
+						return status.Errorf(codes.FailedPrecondition, "%s=%s is still referenced by %d owner(s)", md.Key, md.Value, n)
+					}
 					// Value exists, so remove it
+					key.forget(md.Value)
 					remaining := append(key.Values[:j], key.Values[j+1:]...)
 					key.Values = remaining
+					m.dropEmptyKeys()
 					return nil
 				}
 			}
 		}
 	}
-	return fmt.Errorf("not-found")
+	return status.Errorf(codes.NotFound, "%s=%s not found", md.Key, md.Value)
+}
+
+// deleteKey removes the key with all its values, unless some value is still referenced.
+func (m *Metadata) deleteKey(name string) ([]*pb.Metadata, error) {
+	name = Normalize(name)
+	for i := range m.Keys {
+		key := &m.Keys[i]
+		if key.Name != name {
+			continue
+		}
+		var removed []*pb.Metadata
+		for _, v := range key.Values {
+			if n := key.RefCount(v); n > 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "%s=%s is still referenced by %d owner(s)", name, v, n)
+			}
+			removed = append(removed, &pb.Metadata{Key: name, Value: v})
+		}
+		m.Keys = append(m.Keys[:i], m.Keys[i+1:]...)
+		return removed, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "key %s not found", name)
+}
+
+// dropEmptyKeys removes the keys without any value left.
+func (m *Metadata) dropEmptyKeys() {
+	kept := m.Keys[:0:0]
+	for _, k := range m.Keys {
+		if len(k.Values) > 0 {
+			kept = append(kept, k)
+		}
+	}
+	if len(kept) != len(m.Keys) {
+		m.Keys = kept
+	}
 }
 
 type VersionedStore struct {
 	Version string `json:"version"`
+	// Revision increments on every change of the metadata of the project.
+	Revision uint64 `json:"revision,omitempty"`
+	// Renames lists the values renamed by the transaction producing this version, for the
+	// watchers of the change. They are never persisted.
+	Renames []Rename `json:"-"`
+}
+
+// CheckRevision fails with FailedPrecondition when an expected revision is given
+// and the store is at another one.
+func (v *VersionedStore) CheckRevision(expected *uint64) error {
+	if expected != nil && *expected != v.Revision {
+		return status.Errorf(codes.FailedPrecondition, "revision mismatch: expected %d, current revision is %d", *expected, v.Revision)
+	}
+	return nil
 }
 
 func (s *MetadataStoreV1) GetJson() ([]byte, error) {
@@ -122,27 +390,97 @@ func (s *MetadataStoreV1) GetKeyValues() ([]*pb.StoredMetadata, error) {
 }
 
 func (s *MetadataStoreV1) CreateOrUpdate(k *pb.Metadata) error {
-	lock.Lock()
-	defer lock.Unlock()
-
-	s.createOrUpdate(k)
+	_, _, err := s.createOrUpdate(k)
+	return err
+}
 
-	return nil
+// CreateOrUpdateList stores every entry of the list, splitting them (in their stored form)
+// between the ones that were added and the ones that were already present.
+// It fails on the first entry rejected by the schema of its key.
+func (s *MetadataStoreV1) CreateOrUpdateList(list []*pb.Metadata) (created []*pb.Metadata, existing []*pb.Metadata, err error) {
+	for _, k := range list {
+		md, added, err := s.createOrUpdate(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		if added {
+			created = append(created, md)
+		} else {
+			existing = append(existing, md)
+		}
+	}
+	return created, existing, nil
 }
 
 func (s *MetadataStoreV1) Delete(k *pb.Metadata) error {
-	lock.Lock()
-	defer lock.Unlock()
-
 	return s.delete(k)
 }
 
+// DeleteList removes every entry of the list, failing on the first one that can't be removed.
+func (s *MetadataStoreV1) DeleteList(list []*pb.Metadata) error {
+	for _, k := range list {
+		if err := s.delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteKey removes the key with all its values, returning the removed values.
+func (s *MetadataStoreV1) DeleteKey(name string) ([]*pb.Metadata, error) {
+	return s.deleteKey(name)
+}
+
 // MetadataStoreV1 Supports Project isolation.
 type MetadataStoreV1 struct {
 	VersionedStore
 	Metadata
 }
 
+// clone returns a deep copy of the store.
+func (s *MetadataStoreV1) clone() *MetadataStoreV1 {
+	c := &MetadataStoreV1{VersionedStore: s.VersionedStore}
+	c.Renames = nil
+	if s.Keys != nil {
+		c.Keys = make([]Key, len(s.Keys))
+		for i, k := range s.Keys {
+			c.Keys[i] = Key{Name: k.Name, Display: k.Display}
+			if k.Values != nil {
+				c.Keys[i].Values = append(make([]string, 0, len(k.Values)), k.Values...)
+			}
+			if k.DisplayValues != nil {
+				c.Keys[i].DisplayValues = make(map[string]string, len(k.DisplayValues))
+				for v, display := range k.DisplayValues {
+					c.Keys[i].DisplayValues[v] = display
+				}
+			}
+			c.Keys[i].Owners = cloneSets(k.Owners)
+			c.Keys[i].Sources = cloneSets(k.Sources)
+			if k.Times != nil {
+				c.Keys[i].Times = make(map[string]ValueTimes, len(k.Times))
+				for v, times := range k.Times {
+					c.Keys[i].Times[v] = times
+				}
+			}
+		}
+	}
+	// schemas are replaced, never modified in place
+	c.Schemas = s.Schemas
+	c.Profile = s.Profile
+	return c
+}
+
+func cloneSets(sets map[string][]string) map[string][]string {
+	if sets == nil {
+		return nil
+	}
+	c := make(map[string][]string, len(sets))
+	for v, set := range sets {
+		c[v] = append([]string(nil), set...)
+	}
+	return c
+}
+
 func loadFile(fileName string) ([]byte, error) {
 	file, err := os.Open(fileName)
 	if err != nil {
@@ -196,30 +534,42 @@ func getFilename(folder, projectId string) string {
 
 // SaveMetadataV1 Saves data to a v1 file
 func SaveMetadataV1(data *MetadataStoreV1, persistFolder, defaultProjectId string) error {
-	bytes, err := data.GetJson()
+	bytes, err := encodeMetadataV1(data)
 	if err != nil {
 		return err
 	}
 
 	filename := getFilename(persistFolder, defaultProjectId)
 	log.Infof("Saving metadata to file (%s): %+v", filename, data)
-	return os.WriteFile(filename, bytes, 0644)
+	return writeFileAtomic(filename, bytes, 0644)
 }
 
-// LoadMetadataV1 Loads data from a v1 file
+// LoadMetadataV1 Loads data from a v1 file.
+// A corrupted file is quarantined and reported with a DataLoss error.
 func LoadMetadataV1(persistFolder, defaultProjectId string) (*MetadataStoreV1, error) {
-	bytes, err := loadFile(getFilename(persistFolder, defaultProjectId))
+	filename := getFilename(persistFolder, defaultProjectId)
+	if err := quarantined(filename, defaultProjectId); err != nil {
+		return nil, err
+	}
+	bytes, err := loadFile(filename)
 	if err != nil {
 		return nil, err
 	}
-	m := MetadataStoreV1{}
-	_ = json.Unmarshal(bytes, &m)
-	return &m, nil
+	m, err := decodeMetadataV1(bytes)
+	if err != nil {
+		return nil, quarantine(filename, defaultProjectId, err)
+	}
+	return m, nil
 }
 
 func DeleteProject(persistFolder, projectId string) error {
 	fileName := getFilename(persistFolder, projectId)
 
+	// the project starts anew, its corrupted files are kept for inspection only
+	if err := discardQuarantined(fileName); err != nil {
+		log.Errorf("Failed to discard the corrupted files of project %s: %v", projectId, err)
+		return status.Error(codes.Unknown, err.Error())
+	}
 	err := os.Remove(fileName)
 
 	if err != nil {
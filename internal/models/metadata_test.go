@@ -12,6 +12,8 @@ import (
 
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const jsonmetadataV0 = `{"keys":[{"name":"foo","values":["bar","baz"]}]}`
@@ -25,7 +27,7 @@ const jsonmetadataV1 = `{"version":"v1","keys":[{"name":"foo","values":["bar","r
 
 var expectedMetadataV1 = &MetadataStoreV1{
 	VersionedStore{Version: "v1"},
-	Metadata{[]Key{{
+	Metadata{Keys: []Key{{
 		Name:   "foo",
 		Values: []string{"bar", "rab"},
 	}}},
@@ -82,23 +84,81 @@ func TestMetadata_createOrUpdate(t *testing.T) {
 	}{
 		{"add-one", Metadata{}, args{k: []pb.Metadata{{Key: "foo", Value: "bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}}}},
 		{"add-two", Metadata{}, args{k: []pb.Metadata{{Key: "foo", Value: "bar"}, {Key: "one", Value: "two"}}}, []Key{{Name: "foo", Values: []string{"bar"}}, {Name: "one", Values: []string{"two"}}}},
-		{"add-lowercase", Metadata{}, args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}}}},
-		{"add-idempotent", Metadata{}, args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}, {Key: "foo", Value: "bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}}}},
+		{"add-lowercase", Metadata{}, args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}, Display: "Foo", DisplayValues: map[string]string{"bar": "Bar"}}}},
+		{"add-idempotent", Metadata{}, args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}, {Key: "foo", Value: "bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}, Display: "Foo", DisplayValues: map[string]string{"bar": "Bar"}}}},
 		{"update", Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}, args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}, {Key: "foo", Value: "bar"}}}, []Key{{Name: "foo", Values: []string{"bar"}}}},
+		{
+			"acquire",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "o2"}, {Key: "foo", Value: "rab", Owner: "o1"}, {Key: "foo", Value: "bar", Owner: "o1"}, {Key: "foo", Value: "bar", Owner: "o2"}}},
+			[]Key{{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o1", "o2"}, "rab": {"o1"}}}},
+		},
+		{
+			"sources",
+			Metadata{},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Source: "cluster-orch"}, {Key: "foo", Value: "bar", Source: "App-Orch"}, {Key: "foo", Value: "rab"}}},
+			[]Key{{Name: "foo", Values: []string{"bar", "rab"}, Sources: map[string][]string{"bar": {"app-orch", "cluster-orch"}}}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &tt.fields
 
-			for _, pb := range tt.args.k { //nolint:all,copylocks
-				m.createOrUpdate(&pb)
+			for i := range tt.args.k {
+				m.createOrUpdate(&tt.args.k[i])
 			}
 
-			assert.Equal(t, tt.wants, m.Keys)
+			assert.Equal(t, tt.wants, withoutTimes(m.Keys))
 		})
 	}
 }
 
+func TestMetadataStoreV1_CreateOrUpdateList(t *testing.T) {
+	m := &MetadataStoreV1{Metadata: Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}}
+
+	created, existing, err := m.CreateOrUpdateList([]*pb.Metadata{
+		{Key: "foo", Value: "bar"},
+		{Key: "Foo", Value: "Rab"},
+		{Key: "new", Value: "value"},
+		{Key: "new", Value: "VALUE"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.Metadata{{Key: "foo", Value: "rab"}, {Key: "new", Value: "value"}}, created)
+	assert.Equal(t, []*pb.Metadata{{Key: "foo", Value: "bar"}, {Key: "new", Value: "value"}}, existing)
+	assert.Equal(t, []Key{
+		{Name: "foo", Values: []string{"bar", "rab"}, DisplayValues: map[string]string{"rab": "Rab"}},
+		{Name: "new", Values: []string{"value"}},
+	}, withoutTimes(m.Keys))
+}
+
+func TestMetadata_GetKeyValues_RefCounts(t *testing.T) {
+	m := &Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o1", "o2"}}}}}
+
+	got, err := m.GetKeyValues()
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "foo", Values: []string{"bar", "rab"}, RefCounts: map[string]uint32{"bar": 2}}}, got)
+}
+
+func TestMetadata_FromSource(t *testing.T) {
+	m := &Metadata{Keys: []Key{
+		{
+			Name:    "foo",
+			Values:  []string{"bar", "rab", "baz"},
+			Owners:  map[string][]string{"bar": {"o1"}, "rab": {"o2"}},
+			Sources: map[string][]string{"bar": {"app-orch", "edge-infra"}, "rab": {"cluster-orch"}, "baz": {"app-orch"}},
+		},
+		{Name: "other", Values: []string{"v"}},
+	}}
+
+	assert.Equal(t, &Metadata{Keys: []Key{{
+		Name:    "foo",
+		Values:  []string{"bar", "baz"},
+		Owners:  map[string][]string{"bar": {"o1"}},
+		Sources: map[string][]string{"bar": {"app-orch"}, "baz": {"app-orch"}},
+	}}}, m.FromSource("App-Orch"))
+	assert.Equal(t, &Metadata{}, m.FromSource("unknown"))
+}
+
 func TestMetadata_delete(t *testing.T) {
 
 	type args struct {
@@ -114,32 +174,87 @@ func TestMetadata_delete(t *testing.T) {
 		{"missing-key", Metadata{Keys: []Key{{Name: "foo", Values: []string{}}}}, args{k: []pb.Metadata{{Key: "missing"}}}, []Key{{Name: "foo", Values: []string{}}}, assert.Error},
 		{
 			"remove-one",
-			Metadata{[]Key{{Name: "foo", Values: []string{"bar", "rab"}}}},
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar", "rab"}}}},
 			args{k: []pb.Metadata{{Key: "foo", Value: "bar"}}},
-			[]Key{{"foo", []string{"rab"}}},
+			[]Key{{Name: "foo", Values: []string{"rab"}}},
 			assert.NoError,
 		},
 		{
 			"remove-last",
-			Metadata{[]Key{{Name: "foo", Values: []string{"bar"}}}},
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}},
 			args{k: []pb.Metadata{{Key: "foo", Value: "bar"}}},
-			[]Key{{"foo", []string{}}}, // FIXME NEX-1652 -> the entire key should be removed
+			[]Key{},
 			assert.NoError,
 		},
 		{
 			"remove-lowercase",
-			Metadata{[]Key{{Name: "foo", Values: []string{"bar"}}}},
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}},
 			args{k: []pb.Metadata{{Key: "Foo", Value: "Bar"}}},
-			[]Key{{"foo", []string{}}},
+			[]Key{},
+			assert.NoError,
+		},
+		{
+			"release-one",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1", "o2"}}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "o1"}}},
+			[]Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o2"}}}},
 			assert.NoError,
 		},
+		{
+			"release-not-held",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1", "o2"}}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "unknown"}}},
+			[]Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1", "o2"}}}},
+			func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			"release-last",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o1", "o2"}}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "o1"}, {Key: "foo", Value: "bar", Owner: "o2"}}},
+			[]Key{{Name: "foo", Values: []string{"rab"}}},
+			assert.NoError,
+		},
+		{
+			"remove-sources",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar", "rab"}, Sources: map[string][]string{"bar": {"app-orch"}, "rab": {"app-orch"}}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar"}}},
+			[]Key{{Name: "foo", Values: []string{"rab"}, Sources: map[string][]string{"rab": {"app-orch"}}}},
+			assert.NoError,
+		},
+		{
+			"release-last-of-key",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1"}}}, {Name: "other", Values: []string{"v"}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "o1"}}},
+			[]Key{{Name: "other", Values: []string{"v"}}},
+			assert.NoError,
+		},
+		{
+			"release-unowned",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar", Owner: "o1"}}},
+			[]Key{{Name: "foo", Values: []string{"bar"}}},
+			func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			"remove-referenced",
+			Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1"}}}}},
+			args{k: []pb.Metadata{{Key: "foo", Value: "bar"}}},
+			[]Key{{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1"}}}},
+			func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &tt.fields
 
-			for _, pb := range tt.args.k { //nolint:all,copylocks
-				err := m.delete(&pb)
+			for i := range tt.args.k {
+				err := m.delete(&tt.args.k[i])
 				if !tt.wantsErr(t, err, fmt.Sprintf("LoadMetadataV0(%v)", persistFile)) {
 					return
 				}
@@ -150,6 +265,25 @@ func TestMetadata_delete(t *testing.T) {
 	}
 }
 
+func TestMetadata_deleteKey(t *testing.T) {
+	m := &Metadata{Keys: []Key{
+		{Name: "foo", Values: []string{"bar", "rab"}, Sources: map[string][]string{"bar": {"app-orch"}}},
+		{Name: "shared", Values: []string{"v"}, Owners: map[string][]string{"v": {"o1"}}},
+		{Name: "other", Values: []string{"v"}},
+	}}
+
+	removed, err := m.deleteKey("Foo")
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.Metadata{{Key: "foo", Value: "bar"}, {Key: "foo", Value: "rab"}}, removed)
+	assert.Equal(t, []string{"shared", "other"}, []string{m.Keys[0].Name, m.Keys[1].Name})
+
+	_, err = m.deleteKey("foo")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = m.deleteKey("shared")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Len(t, m.Keys, 2)
+}
+
 func TestSaveMetadataV1(t *testing.T) {
 	type args struct {
 		data *MetadataStoreV1
@@ -165,7 +299,8 @@ func TestSaveMetadataV1(t *testing.T) {
 			args{
 				data: &MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar", "rab"}}}}},
 			},
-			`{"version":"v1","keys":[{"name":"foo","values":["bar","rab"]}]}`,
+			`{"checksum":"sha256:40a72c3a21eaf3b1b55ccc6b5bda00fd544459910b02fea8a7fc285983cf201b",` +
+				`"metadata":{"version":"v1","keys":[{"name":"foo","values":["bar","rab"]}]}}`,
 			assert.NoError,
 		},
 	}
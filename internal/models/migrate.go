@@ -6,12 +6,17 @@
 package models
 
 import (
+	"database/sql"
+	"fmt"
 	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-type migration func(*string, *string, *string) error
+type migration func(*string, Store, *string) error
 
-func migrateV0(persistData, persistFolder, defaultProjectId *string) error {
+func migrateV0(persistData *string, store Store, defaultProjectId *string) error {
 	log.Info("Migrating data from v0 to v1")
 	metadata, err := LoadMetadataV0(*persistData)
 	if err != nil {
@@ -19,6 +24,7 @@ func migrateV0(persistData, persistFolder, defaultProjectId *string) error {
 	}
 
 	log.Debugf("Existing data: %+v", metadata)
+	metadata.dropEmptyKeys()
 
 	newData := &MetadataStoreV1{
 		VersionedStore{Version: "v1"},
@@ -26,7 +32,7 @@ func migrateV0(persistData, persistFolder, defaultProjectId *string) error {
 	}
 	log.Infof("New Data: %+v", newData)
 
-	err = SaveMetadataV1(newData, *persistFolder, *defaultProjectId)
+	err = store.Save(*defaultProjectId, newData)
 	if err != nil {
 		return err
 	}
@@ -43,15 +49,155 @@ var migrations = map[string]migration{
 	"v0": migrateV0,
 }
 
-// Migrate receives the content of the backup file.
-// If the content does not match the latest format it applies the required migration(s).
-func Migrate(persistData, persistFolder, defaultProjectId string) error {
-	log.Infof("Migrating (persistData: %s, persistFolder: %s, defaultProjectId: %s)", persistData, persistFolder, defaultProjectId)
+// schemaMigrations holds the statements bringing the SQLite schema from one version to the next,
+// schemaMigrations[i] upgrades the database to version i+1.
+// Migrations are append only: never change a statement once released.
+var schemaMigrations = []string{
+	// v1: projects, their keys and the values of each key
+	`CREATE TABLE projects (
+		id      TEXT PRIMARY KEY,
+		version TEXT NOT NULL DEFAULT ''
+	);
+	CREATE TABLE keys (
+		project_id TEXT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		name       TEXT NOT NULL,
+		position   INTEGER NOT NULL,
+		PRIMARY KEY (project_id, name)
+	);
+	CREATE TABLE key_values (
+		project_id TEXT NOT NULL,
+		key_name   TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		position   INTEGER NOT NULL,
+		PRIMARY KEY (project_id, key_name, value),
+		FOREIGN KEY (project_id, key_name) REFERENCES keys (project_id, name) ON DELETE CASCADE
+	);`,
+	// v2: revision of each project
+	`ALTER TABLE projects ADD COLUMN revision INTEGER NOT NULL DEFAULT 0;`,
+	// v3: owners referencing each value
+	`CREATE TABLE value_owners (
+		project_id TEXT NOT NULL,
+		key_name   TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		owner      TEXT NOT NULL,
+		PRIMARY KEY (project_id, key_name, value, owner),
+		FOREIGN KEY (project_id, key_name, value) REFERENCES key_values (project_id, key_name, value) ON DELETE CASCADE
+	);`,
+	// v4: components that wrote each value
+	`CREATE TABLE value_sources (
+		project_id TEXT NOT NULL,
+		key_name   TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		source     TEXT NOT NULL,
+		PRIMARY KEY (project_id, key_name, value, source),
+		FOREIGN KEY (project_id, key_name, value) REFERENCES key_values (project_id, key_name, value) ON DELETE CASCADE
+	);`,
+	// v5: creation and last seen times (unix seconds) of each value, NULL when unknown
+	`ALTER TABLE key_values ADD COLUMN created_at INTEGER;
+	ALTER TABLE key_values ADD COLUMN last_seen_at INTEGER;`,
+	// v6: schemas constraining the values of a key, allowed_values is a JSON array
+	`CREATE TABLE key_schemas (
+		project_id     TEXT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		key_name       TEXT NOT NULL,
+		allowed_values TEXT NOT NULL DEFAULT '[]',
+		pattern        TEXT NOT NULL DEFAULT '',
+		max_values     INTEGER NOT NULL DEFAULT 0,
+		PRIMARY KEY (project_id, key_name)
+	);`,
+	// v7: display forms of the keys and values, NULL when the same as the stored lowercase form
+	`ALTER TABLE keys ADD COLUMN display_name TEXT;
+	ALTER TABLE key_values ADD COLUMN display_value TEXT;`,
+	// v8: validation profiles of the projects and of the keys with a schema, empty for the default
+	`ALTER TABLE projects ADD COLUMN profile TEXT NOT NULL DEFAULT '';
+	ALTER TABLE key_schemas ADD COLUMN profile TEXT NOT NULL DEFAULT '';`,
+}
+
+// MigrateSchema applies the pending schemaMigrations to a SQLite database, one version at a time.
+// The applied versions are recorded in the schema_migrations table, a failed step is rolled
+// back and reported with the versions it was migrating from and to.
+func MigrateSchema(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return err
+	}
+
+	var current int
+	if err := db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return err
+	}
+	if current > len(schemaMigrations) {
+		return fmt.Errorf("database schema version %d is newer than the supported version %d", current, len(schemaMigrations))
+	}
+	if current == len(schemaMigrations) {
+		log.Infof("Database schema is up to date at version %d", current)
+		return nil
+	}
+
+	log.Infof("Migrating database schema from version %d to %d", current, len(schemaMigrations))
+	for version := current + 1; version <= len(schemaMigrations); version++ {
+		if err := migrateSchemaVersion(db, version); err != nil {
+			log.Errorf("Database schema migration from version %d to %d failed: %v", version-1, version, err)
+			return fmt.Errorf("schema migration from version %d to %d: %w", version-1, version, err)
+		}
+		log.Infof("Migrated database schema from version %d to %d", version-1, version)
+	}
+	return nil
+}
+
+// migrateSchemaVersion upgrades the database to version in a transaction recording it.
+func migrateSchemaVersion(db *sql.DB, version int) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(schemaMigrations[version-1]); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// schemaStore is implemented by the stores whose schema must be brought up to date before they are used.
+type schemaStore interface {
+	migrateSchema() error
+}
+
+// Migrate brings a freshly opened store up to the latest format, it's the single entry point of
+// the upgrades of every backend and must run before the store is used:
+//   - the schema of the database backends is migrated,
+//   - the metadata-<project>.json files of persistFolder are imported into the stores that are not file based,
+//   - with a defaultProjectId, the persistData backup file of the 24.08 format is converted for that project.
+func Migrate(persistFolder string, persistData string, store Store, defaultProjectId string) error {
+	log.Infof("Migrating (persistFolder: %s, persistData: %s, defaultProjectId: %s)", persistFolder, persistData, defaultProjectId)
+
+	if s, ok := store.(schemaStore); ok {
+		if err := s.migrateSchema(); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := store.(*FileStore); !ok {
+		// one-shot import of the per-project JSON files
+		if err := MigrateFiles(persistFolder, store); err != nil {
+			return err
+		}
+	}
 
+	if defaultProjectId == "" {
+		log.Info("No default project, the migration of the 24.08 format is skipped")
+		return nil
+	}
 	if _, e := os.Stat(persistData); e == nil {
 		// else read the data, convert them in the new format and write them back into a file
 		// note that the file is suffixed with the defaultProjectId
-		return migrations["v0"](&persistData, &persistFolder, &defaultProjectId)
+		return migrations["v0"](&persistData, store, &defaultProjectId)
 	} else {
 		// if there is no persistData file we're starting fresh, nothing to do for now
 		log.Info("There are no persisted data in the 24.08 format, nothing to do", persistData)
@@ -66,3 +212,35 @@ func Migrate(persistData, persistFolder, defaultProjectId string) error {
 	// no migration required
 	return nil
 }
+
+// MigrateFiles imports the metadata-<project>.json files found in persistFolder into store.
+// It is meant for stores that are not file based: every imported file is renamed
+// with a ".migrated" suffix so that the import happens only once.
+func MigrateFiles(persistFolder string, store Store) error {
+	files := NewFileStore(persistFolder)
+	projects, err := files.Projects()
+	if err != nil {
+		return err
+	}
+
+	for _, projectId := range projects {
+		log.Infof("Migrating metadata file of project %s", projectId)
+		data, err := files.Load(projectId)
+		if status.Code(err) == codes.DataLoss {
+			// the file has been quarantined, keep importing the other projects
+			log.Errorf("Skipping migration of project %s: %v", projectId, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := store.Save(projectId, data); err != nil {
+			return err
+		}
+		fileName := getFilename(persistFolder, projectId)
+		if err := os.Rename(fileName, fileName+".migrated"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
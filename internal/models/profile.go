@@ -0,0 +1,146 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validation profiles, selecting the characters and length accepted in keys and values.
+const (
+	// ProfileK8sLabel accepts Kubernetes label compatible values, it is the default.
+	ProfileK8sLabel = "k8s-label"
+	// ProfileExtended accepts longer values with Unicode letters, spaces, dots and underscores.
+	ProfileExtended = "extended"
+)
+
+type validationProfile struct {
+	maxLen  int
+	pattern *regexp.Regexp
+	allowed string
+}
+
+var validationProfiles = map[string]validationProfile{
+	ProfileK8sLabel: {
+		maxLen:  40,
+		pattern: regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]{0,38}[A-Za-z0-9]?$`),
+		allowed: "letters, digits and dashes, starting with a letter or digit",
+	},
+	ProfileExtended: {
+		maxLen:  253,
+		pattern: regexp.MustCompile(`^[\p{L}\p{N}]([\p{L}\p{M}\p{N} ._-]*[\p{L}\p{M}\p{N}])?$`),
+		allowed: "letters, digits, spaces, dots, underscores and dashes, starting and ending with a letter or digit",
+	},
+}
+
+// ProfileFromProto returns the name of the profile, empty when unspecified.
+func ProfileFromProto(p pb.ValidationProfile) (string, error) {
+	switch p {
+	case pb.ValidationProfile_VALIDATION_PROFILE_UNSPECIFIED:
+		return "", nil
+	case pb.ValidationProfile_VALIDATION_PROFILE_K8S_LABEL:
+		return ProfileK8sLabel, nil
+	case pb.ValidationProfile_VALIDATION_PROFILE_EXTENDED:
+		return ProfileExtended, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unknown validation profile %v", p)
+}
+
+// ProfileToProto returns the API form of the profile.
+func ProfileToProto(profile string) pb.ValidationProfile {
+	switch profile {
+	case ProfileK8sLabel:
+		return pb.ValidationProfile_VALIDATION_PROFILE_K8S_LABEL
+	case ProfileExtended:
+		return pb.ValidationProfile_VALIDATION_PROFILE_EXTENDED
+	}
+	return pb.ValidationProfile_VALIDATION_PROFILE_UNSPECIFIED
+}
+
+// checkProfile returns why the profile rejects the key or value s, or an empty string.
+func checkProfile(profile, what, s string) string {
+	p, ok := validationProfiles[profile]
+	if !ok {
+		p, profile = validationProfiles[ProfileK8sLabel], ProfileK8sLabel
+	}
+	if n := utf8.RuneCountInString(s); n > p.maxLen {
+		return fmt.Sprintf("%s of %d characters is too long for the %s profile, it accepts at most %d", what, n, profile, p.maxLen)
+	}
+	if !p.pattern.MatchString(s) {
+		return fmt.Sprintf("%s %q is invalid for the %s profile, it accepts %s", what, s, profile, p.allowed)
+	}
+	return ""
+}
+
+// ProjectProfile returns the validation profile of the project.
+func (m *Metadata) ProjectProfile() string {
+	if m.Profile != "" {
+		return m.Profile
+	}
+	return ProfileK8sLabel
+}
+
+// profile returns the validation profile of the key: the profile of its schema, else the
+// profile of the project.
+func (m *Metadata) profile(key string) string {
+	if s := m.schema(key); s != nil && s.Profile != "" {
+		return s.Profile
+	}
+	return m.ProjectProfile()
+}
+
+// checkProfile returns why the validation profile of the key rejects its name or the values.
+func (m *Metadata) checkProfile(key string, values ...string) string {
+	profile := m.profile(key)
+	if reason := checkProfile(profile, "key", key); reason != "" {
+		return reason
+	}
+	for _, v := range values {
+		if reason := checkProfile(profile, "value", v); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+// violation returns why the validation profile or the schema of the key rejects its stored
+// name or values, or an empty string.
+func (m *Metadata) violation(key string) string {
+	i := m.indexOf(key)
+	if i < 0 {
+		return ""
+	}
+	if reason := m.checkProfile(key, m.Keys[i].Values...); reason != "" {
+		return reason
+	}
+	if s := m.schema(key); s != nil {
+		return s.checkKey(&m.Keys[i])
+	}
+	return ""
+}
+
+// SetProfile selects the validation profile of the project, empty for the default one.
+// It fails with FailedPrecondition if the stored keys or values violate it.
+func (m *Metadata) SetProfile(profile string) error {
+	if _, ok := validationProfiles[profile]; profile != "" && !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown validation profile %s", profile)
+	}
+	previous := m.Profile
+	m.Profile = profile
+	for _, k := range m.Keys {
+		if reason := m.violation(k.Name); reason != "" {
+			m.Profile = previous
+			return status.Errorf(codes.FailedPrecondition, "the stored metadata violates the profile: %s", reason)
+		}
+	}
+	return nil
+}
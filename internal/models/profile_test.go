@@ -0,0 +1,79 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckProfile(t *testing.T) {
+	tests := []struct {
+		profile string
+		value   string
+		valid   bool
+	}{
+		{ProfileK8sLabel, "acme-corp", true},
+		{ProfileK8sLabel, "ACME-Corp", true},
+		{ProfileK8sLabel, "a", true},
+		{ProfileK8sLabel, strings.Repeat("a", 40), true},
+		{ProfileK8sLabel, strings.Repeat("a", 41), false},
+		{ProfileK8sLabel, "-acme", false},
+		{ProfileK8sLabel, "acme corp", false},
+		{ProfileK8sLabel, "acme.corp", false},
+		{ProfileK8sLabel, "société", false},
+		{ProfileExtended, "acme-corp", true},
+		{ProfileExtended, "Société Générale S.A", true},
+		{ProfileExtended, "株式会社", true},
+		{ProfileExtended, "acme_corp.eu", true},
+		{ProfileExtended, strings.Repeat("é", 253), true},
+		{ProfileExtended, strings.Repeat("é", 254), false},
+		{ProfileExtended, " acme", false},
+		{ProfileExtended, "acme.", false},
+		{ProfileExtended, "acme/corp", false},
+		{"", "acme corp", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile+"/"+tt.value, func(t *testing.T) {
+			reason := checkProfile(tt.profile, "value", tt.value)
+			assert.Equal(t, tt.valid, reason == "", reason)
+		})
+	}
+}
+
+func TestMetadata_profile(t *testing.T) {
+	m := &Metadata{}
+	_, _, err := m.createOrUpdate(&pb.Metadata{Key: "customer", Value: "Société Générale"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a key with a profile of its own
+	assert.NoError(t, m.SetSchema(KeySchema{Key: "customer", Profile: ProfileExtended}))
+	_, _, err = m.createOrUpdate(&pb.Metadata{Key: "customer", Value: "Société Générale"})
+	assert.NoError(t, err)
+	_, _, err = m.createOrUpdate(&pb.Metadata{Key: "site", Value: "Paris 1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(m.SetSchema(KeySchema{Key: "customer", Profile: ProfileK8sLabel})))
+	assert.Equal(t, codes.InvalidArgument, status.Code(m.SetSchema(KeySchema{Key: "site", AllowedValues: []string{"paris 1"}})))
+
+	// the profile of the project
+	assert.NoError(t, m.SetProfile(ProfileExtended))
+	_, _, err = m.createOrUpdate(&pb.Metadata{Key: "site", Value: "Paris 1"})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(m.SetProfile("")))
+	assert.Equal(t, ProfileExtended, m.ProjectProfile())
+	assert.Equal(t, codes.InvalidArgument, status.Code(m.SetProfile("unknown")))
+
+	// renamed keys and merged values are validated as well
+	assert.NoError(t, m.DeleteSchema("customer"))
+	assert.NoError(t, m.SetSchema(KeySchema{Key: "zone", Profile: ProfileK8sLabel}))
+	_, err = m.renameKey("customer", "zone")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,103 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"sort"
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+)
+
+// SelectKeys returns only the keys named (case-insensitively), in the stored order.
+func (m *Metadata) SelectKeys(names []string) *Metadata {
+	wanted := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		wanted[Normalize(name)] = struct{}{}
+	}
+	selected := &Metadata{}
+	for _, k := range m.Keys {
+		if _, ok := wanted[k.Name]; ok {
+			selected.Keys = append(selected.Keys, k)
+		}
+	}
+	return selected
+}
+
+// Ordered returns the keys, and the values of each key, sorted by order. Keys and values
+// that rank the same keep their stored order. The metadata itself is left untouched.
+//
+//   - alphabetical: by name
+//   - recently used: the values last seen most recently first, a key ranks as its most recent value
+//   - popularity: the values with most references first, a key ranks as the total of its values
+//     and then by its number of values
+func (m *Metadata) Ordered(order pb.GetMetadataRequest_OrderBy) *Metadata {
+	if order == pb.GetMetadataRequest_ORDER_BY_UNSPECIFIED {
+		return m
+	}
+
+	ordered := &Metadata{Keys: make([]Key, len(m.Keys))}
+	for i, k := range m.Keys {
+		k.Values = append([]string(nil), k.Values...)
+		sort.SliceStable(k.Values, func(a, b int) bool {
+			return valueBefore(&k, k.Values[a], k.Values[b], order)
+		})
+		ordered.Keys[i] = k
+	}
+	sort.SliceStable(ordered.Keys, func(a, b int) bool {
+		return keyBefore(&ordered.Keys[a], &ordered.Keys[b], order)
+	})
+	return ordered
+}
+
+func valueBefore(k *Key, a, b string, order pb.GetMetadataRequest_OrderBy) bool {
+	switch order {
+	case pb.GetMetadataRequest_ORDER_BY_ALPHABETICAL:
+		return a < b
+	case pb.GetMetadataRequest_ORDER_BY_RECENTLY_USED:
+		return k.Times[a].LastSeenAt.After(k.Times[b].LastSeenAt)
+	case pb.GetMetadataRequest_ORDER_BY_POPULARITY:
+		return k.RefCount(a) > k.RefCount(b)
+	default:
+		return false
+	}
+}
+
+func keyBefore(a, b *Key, order pb.GetMetadataRequest_OrderBy) bool {
+	switch order {
+	case pb.GetMetadataRequest_ORDER_BY_ALPHABETICAL:
+		return a.Name < b.Name
+	case pb.GetMetadataRequest_ORDER_BY_RECENTLY_USED:
+		return a.lastSeen().After(b.lastSeen())
+	case pb.GetMetadataRequest_ORDER_BY_POPULARITY:
+		if ra, rb := a.refCounts(), b.refCounts(); ra != rb {
+			return ra > rb
+		}
+		return len(a.Values) > len(b.Values)
+	default:
+		return false
+	}
+}
+
+// lastSeen returns when a value of the key was last seen.
+func (k *Key) lastSeen() time.Time {
+	var last time.Time
+	for _, times := range k.Times {
+		if times.LastSeenAt.After(last) {
+			last = times.LastSeenAt
+		}
+	}
+	return last
+}
+
+// refCounts returns the number of references to all the values of the key.
+func (k *Key) refCounts() int {
+	n := 0
+	for _, owners := range k.Owners {
+		n += len(owners)
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_SelectKeys(t *testing.T) {
+	m := &Metadata{Keys: []Key{
+		{Name: "customer", Values: []string{"culvers"}},
+		{Name: "color", Values: []string{"red"}},
+		{Name: "region", Values: []string{"us"}},
+	}}
+
+	selected := m.SelectKeys([]string{"Region", "customer", "missing"})
+	assert.Equal(t, []Key{m.Keys[0], m.Keys[2]}, selected.Keys)
+	assert.Empty(t, m.SelectKeys([]string{"missing"}).Keys)
+}
+
+func TestMetadata_Ordered(t *testing.T) {
+	at := func(minutes int) ValueTimes {
+		seen := time.Date(2026, 1, 1, 0, minutes, 0, 0, time.UTC)
+		return ValueTimes{CreatedAt: seen, LastSeenAt: seen}
+	}
+	m := &Metadata{Keys: []Key{
+		{
+			Name:   "region",
+			Values: []string{"us", "eu", "apac"},
+			Owners: map[string][]string{"eu": {"a", "b"}, "apac": {"a"}},
+			Times:  map[string]ValueTimes{"us": at(1), "eu": at(5), "apac": at(3)},
+		},
+		{
+			Name:   "customer",
+			Values: []string{"culvers", "acme"},
+			Owners: map[string][]string{"acme": {"a", "b", "c"}},
+			Times:  map[string]ValueTimes{"culvers": at(2), "acme": at(4)},
+		},
+		{
+			Name:   "color",
+			Values: []string{"red", "blue", "green"},
+			Owners: map[string][]string{"red": {"a"}, "green": {"b"}, "blue": {"c"}},
+		},
+	}}
+
+	type key struct {
+		name   string
+		values []string
+	}
+	tests := []struct {
+		name  string
+		order pb.GetMetadataRequest_OrderBy
+		want  []key
+	}{
+		{
+			"creation", pb.GetMetadataRequest_ORDER_BY_UNSPECIFIED,
+			[]key{{"region", []string{"us", "eu", "apac"}}, {"customer", []string{"culvers", "acme"}}, {"color", []string{"red", "blue", "green"}}},
+		},
+		{
+			"alphabetical", pb.GetMetadataRequest_ORDER_BY_ALPHABETICAL,
+			[]key{{"color", []string{"blue", "green", "red"}}, {"customer", []string{"acme", "culvers"}}, {"region", []string{"apac", "eu", "us"}}},
+		},
+		{
+			"recently-used", pb.GetMetadataRequest_ORDER_BY_RECENTLY_USED,
+			[]key{{"region", []string{"eu", "apac", "us"}}, {"customer", []string{"acme", "culvers"}}, {"color", []string{"red", "blue", "green"}}},
+		},
+		{
+			// ties on references are broken by the number of values
+			"popularity", pb.GetMetadataRequest_ORDER_BY_POPULARITY,
+			[]key{{"region", []string{"eu", "apac", "us"}}, {"color", []string{"red", "blue", "green"}}, {"customer", []string{"acme", "culvers"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered := m.Ordered(tt.order)
+			var got []key
+			for _, k := range ordered.Keys {
+				got = append(got, key{k.Name, k.Values})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// the metadata itself keeps its order
+	assert.Equal(t, "region", m.Keys[0].Name)
+	assert.Equal(t, []string{"us", "eu", "apac"}, m.Keys[0].Values)
+}
@@ -0,0 +1,173 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"slices"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rename relates a value to the value it was renamed or merged into.
+type Rename struct {
+	From *pb.Metadata
+	To   *pb.Metadata
+}
+
+// RenameKey moves all the values of the key to another key, merging them with the values
+// already there, and records the renames of the transaction. The schema of the key moves
+// along with it, unless the other key has its own schema, which the values must then satisfy.
+func (s *MetadataStoreV1) RenameKey(from, to string) error {
+	renames, err := s.renameKey(from, to)
+	if err != nil {
+		return err
+	}
+	s.Renames = append(s.Renames, renames...)
+	return nil
+}
+
+// MergeValues merges some values of the key into another value of the key, which is created
+// if needed, and records the renames of the transaction.
+func (s *MetadataStoreV1) MergeValues(key string, values []string, into string) error {
+	renames, err := s.mergeValues(key, values, into)
+	if err != nil {
+		return err
+	}
+	s.Renames = append(s.Renames, renames...)
+	return nil
+}
+
+func (m *Metadata) renameKey(from, to string) ([]Rename, error) {
+	display := to
+	from, to = Normalize(from), Normalize(to)
+	if to == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing new name for key %s", from)
+	}
+	i := m.indexOf(from)
+	if i < 0 {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", from)
+	}
+	if from == to {
+		// only the casing changes, no value moves: the values are renamed from the previous display name
+		previous := m.Keys[i].DisplayName()
+		m.Keys[i].setDisplayName(display)
+		if m.Keys[i].DisplayName() == previous {
+			return nil, nil
+		}
+		var renames []Rename
+		for _, v := range m.Keys[i].Values {
+			renames = append(renames, Rename{From: &pb.Metadata{Key: previous, Value: v}, To: &pb.Metadata{Key: display, Value: v}})
+		}
+		return renames, nil
+	}
+
+	src := m.Keys[i]
+	var renames []Rename
+	for _, v := range src.Values {
+		renames = append(renames, Rename{From: &pb.Metadata{Key: from, Value: v}, To: &pb.Metadata{Key: to, Value: v}})
+	}
+	j := m.indexOf(to)
+	if j < 0 {
+		m.Keys[i].Name = to
+		m.Keys[i].setDisplayName(display)
+	} else {
+		for _, v := range src.Values {
+			m.Keys[j].absorb(v, &src, v)
+		}
+		if display != to {
+			m.Keys[j].setDisplayName(display)
+		}
+		m.Keys = append(m.Keys[:i], m.Keys[i+1:]...)
+	}
+	m.renameSchema(from, to)
+	if err := m.checkKey(to); err != nil {
+		return nil, err
+	}
+	return renames, nil
+}
+
+func (m *Metadata) mergeValues(key string, values []string, into string) ([]Rename, error) {
+	display := into
+	key, into = Normalize(key), Normalize(into)
+	if into == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing value to merge into")
+	}
+	i := m.indexOf(key)
+	if i < 0 {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", key)
+	}
+	k := &m.Keys[i]
+
+	var merged []string
+	for _, v := range values {
+		v = Normalize(v)
+		if v == into || slices.Contains(merged, v) {
+			continue
+		}
+		if !slices.Contains(k.Values, v) {
+			return nil, status.Errorf(codes.NotFound, "%s=%s not found", key, v)
+		}
+		merged = append(merged, v)
+	}
+
+	created := !slices.Contains(k.Values, into)
+	var renames []Rename
+	for _, v := range merged {
+		k.absorb(into, k, v)
+		k.forget(v)
+		k.Values = slices.DeleteFunc(k.Values, func(value string) bool { return value == v })
+		renames = append(renames, Rename{From: &pb.Metadata{Key: key, Value: v}, To: &pb.Metadata{Key: key, Value: into}})
+	}
+	// a new value, or a new casing, is displayed as requested
+	if slices.Contains(k.Values, into) && (created || display != into) {
+		k.setDisplayValue(into, display)
+	}
+	if err := m.checkKey(key); err != nil {
+		return nil, err
+	}
+	return renames, nil
+}
+
+func (m *Metadata) indexOf(name string) int {
+	return slices.IndexFunc(m.Keys, func(k Key) bool { return k.Name == name })
+}
+
+// absorb adds the value v of src to the value into of the key, with its owners and sources
+// (and its display form when the value keeps its name).
+// The merged value is created at the earliest and last seen at the latest of the two.
+func (k *Key) absorb(into string, src *Key, v string) {
+	if !slices.Contains(k.Values, into) {
+		k.AddValue(into)
+		if into == v {
+			k.setDisplayValue(into, src.DisplayValue(v))
+		}
+	}
+	for _, owner := range src.Owners[v] {
+		k.acquire(into, owner)
+	}
+	for _, source := range src.Sources[v] {
+		k.addSource(into, source)
+	}
+
+	times, ok := src.Times[v]
+	if !ok {
+		return
+	}
+	if current, ok := k.Times[into]; ok {
+		if current.CreatedAt.Before(times.CreatedAt) {
+			times.CreatedAt = current.CreatedAt
+		}
+		if current.LastSeenAt.After(times.LastSeenAt) {
+			times.LastSeenAt = current.LastSeenAt
+		}
+	}
+	if k.Times == nil {
+		k.Times = map[string]ValueTimes{}
+	}
+	k.Times[into] = times
+}
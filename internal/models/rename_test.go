@@ -0,0 +1,214 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func rename(fromKey, fromValue, toKey, toValue string) Rename {
+	return Rename{From: &pb.Metadata{Key: fromKey, Value: fromValue}, To: &pb.Metadata{Key: toKey, Value: toValue}}
+}
+
+func TestMetadata_renameKey(t *testing.T) {
+	old, recent := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		keys        []Key
+		from, to    string
+		wantKeys    []Key
+		wantRenames []Rename
+		wantCode    codes.Code
+	}{
+		{
+			"rename",
+			[]Key{{Name: "custmer", Values: []string{"culvers"}, Owners: map[string][]string{"culvers": {"o1"}}}, {Name: "other", Values: []string{"v"}}},
+			"Custmer", "customer",
+			[]Key{{Name: "customer", Values: []string{"culvers"}, Owners: map[string][]string{"culvers": {"o1"}}}, {Name: "other", Values: []string{"v"}}},
+			[]Rename{rename("custmer", "culvers", "customer", "culvers")},
+			codes.OK,
+		},
+		{
+			"merge",
+			[]Key{
+				{
+					Name:   "customer",
+					Values: []string{"culvers"},
+					Owners: map[string][]string{"culvers": {"o1"}},
+					Times:  map[string]ValueTimes{"culvers": {CreatedAt: recent, LastSeenAt: recent}},
+				},
+				{
+					Name:    "custmer",
+					Values:  []string{"acme", "culvers"},
+					Owners:  map[string][]string{"culvers": {"o2"}},
+					Sources: map[string][]string{"acme": {"app-orch"}},
+					Times:   map[string]ValueTimes{"culvers": {CreatedAt: old, LastSeenAt: old}},
+				},
+			},
+			"custmer", "customer",
+			[]Key{{
+				Name:    "customer",
+				Values:  []string{"culvers", "acme"},
+				Owners:  map[string][]string{"culvers": {"o1", "o2"}},
+				Sources: map[string][]string{"acme": {"app-orch"}},
+				Times:   map[string]ValueTimes{"culvers": {CreatedAt: old, LastSeenAt: recent}},
+			}},
+			[]Rename{rename("custmer", "acme", "customer", "acme"), rename("custmer", "culvers", "customer", "culvers")},
+			codes.OK,
+		},
+		{"missing", []Key{{Name: "foo", Values: []string{"bar"}}}, "missing", "foo", []Key{{Name: "foo", Values: []string{"bar"}}}, nil, codes.NotFound},
+		{
+			"recase",
+			[]Key{{Name: "foo", Values: []string{"bar", "baz"}}},
+			"foo", "Foo",
+			[]Key{{Name: "foo", Values: []string{"bar", "baz"}, Display: "Foo"}},
+			[]Rename{rename("foo", "bar", "Foo", "bar"), rename("foo", "baz", "Foo", "baz")},
+			codes.OK,
+		},
+		{
+			"lowercase",
+			[]Key{{Name: "foo", Values: []string{"bar"}, Display: "Foo"}},
+			"FOO", "foo",
+			[]Key{{Name: "foo", Values: []string{"bar"}}},
+			[]Rename{rename("Foo", "bar", "foo", "bar")},
+			codes.OK,
+		},
+		{"same-casing", []Key{{Name: "foo", Values: []string{"bar"}, Display: "Foo"}}, "foo", "Foo", []Key{{Name: "foo", Values: []string{"bar"}, Display: "Foo"}}, nil, codes.OK},
+		{"missing-name", []Key{{Name: "foo", Values: []string{"bar"}}}, "foo", "", []Key{{Name: "foo", Values: []string{"bar"}}}, nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Metadata{Keys: tt.keys}
+			renames, err := m.renameKey(tt.from, tt.to)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantRenames, renames)
+			assert.Equal(t, tt.wantKeys, m.Keys)
+		})
+	}
+}
+
+func TestMetadata_renameKeySchema(t *testing.T) {
+	env := KeySchema{Key: "env", AllowedValues: []string{"dev", "prod"}}
+	stage := KeySchema{Key: "stage", AllowedValues: []string{"dev", "prod", "test"}}
+	tests := []struct {
+		name        string
+		keys        []Key
+		schemas     []KeySchema
+		from, to    string
+		wantSchemas []KeySchema
+	}{
+		{"moved", []Key{{Name: "env", Values: []string{"dev"}}}, []KeySchema{env}, "env", "stage", []KeySchema{{Key: "stage", AllowedValues: env.AllowedValues}}},
+		{"kept", []Key{{Name: "env", Values: []string{"dev"}}}, []KeySchema{env, stage}, "env", "stage", []KeySchema{stage}},
+		{"free-form", []Key{{Name: "env", Values: []string{"dev"}}}, []KeySchema{stage}, "env", "stage", []KeySchema{stage}},
+		{"recase", []Key{{Name: "env", Values: []string{"dev"}}}, []KeySchema{env}, "env", "Env", []KeySchema{env}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Metadata{Keys: tt.keys, Schemas: tt.schemas}
+			_, err := m.renameKey(tt.from, tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSchemas, m.Schemas)
+		})
+	}
+
+	// the new key is validated, the old name is free-form again
+	m := &Metadata{Keys: []Key{{Name: "env", Values: []string{"dev"}}}, Schemas: []KeySchema{env}}
+	_, err := m.renameKey("env", "stage")
+	assert.NoError(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(m.checkAdd("stage", "qa", "qa")))
+	assert.NoError(t, m.checkAdd("env", "qa", "qa"))
+}
+
+func TestMetadata_mergeValues(t *testing.T) {
+	keys := func() []Key {
+		return []Key{{
+			Name:    "customer",
+			Values:  []string{"culvrs", "acme", "kulvers"},
+			Owners:  map[string][]string{"culvrs": {"o1"}, "kulvers": {"o2"}},
+			Sources: map[string][]string{"culvrs": {"app-orch"}},
+		}}
+	}
+	tests := []struct {
+		name        string
+		key         string
+		values      []string
+		into        string
+		wantKeys    []Key
+		wantRenames []Rename
+		wantCode    codes.Code
+	}{
+		{
+			"into-new",
+			"customer", []string{"Culvrs", "kulvers", "culvers"}, "Culvers",
+			[]Key{{
+				Name:          "customer",
+				Values:        []string{"acme", "culvers"},
+				DisplayValues: map[string]string{"culvers": "Culvers"},
+				Owners:        map[string][]string{"culvers": {"o1", "o2"}},
+				Sources:       map[string][]string{"culvers": {"app-orch"}},
+			}},
+			[]Rename{rename("customer", "culvrs", "customer", "culvers"), rename("customer", "kulvers", "customer", "culvers")},
+			codes.OK,
+		},
+		{
+			"into-existing",
+			"customer", []string{"culvrs"}, "acme",
+			[]Key{{
+				Name:    "customer",
+				Values:  []string{"acme", "kulvers"},
+				Owners:  map[string][]string{"acme": {"o1"}, "kulvers": {"o2"}},
+				Sources: map[string][]string{"acme": {"app-orch"}},
+			}},
+			[]Rename{rename("customer", "culvrs", "customer", "acme")},
+			codes.OK,
+		},
+		{"missing-value", "customer", []string{"culvrs", "missing"}, "culvers", keys(), nil, codes.NotFound},
+		{"missing-key", "missing", []string{"culvrs"}, "culvers", keys(), nil, codes.NotFound},
+		{"missing-into", "customer", []string{"culvrs"}, "", keys(), nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Metadata{Keys: keys()}
+			renames, err := m.mergeValues(tt.key, tt.values, tt.into)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantRenames, renames)
+			assert.Equal(t, tt.wantKeys, m.Keys)
+		})
+	}
+}
+
+func TestMemoryStore_Renames(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	assert.NoError(t, err)
+	_, err = m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "custmer", Value: "culvers"})
+	})
+	assert.NoError(t, err)
+
+	var committed []Rename
+	m.OnCommit(func(_ string, _, after *MetadataStoreV1) { committed = after.Renames })
+	_, err = m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.RenameKey("custmer", "customer")
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Rename{rename("custmer", "culvers", "customer", "culvers")}, committed)
+
+	// the renames only belong to the transaction
+	data, err := m.Load(projectId)
+	assert.NoError(t, err)
+	assert.Nil(t, data.Renames)
+	_, err = m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "color", Value: "red"})
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, committed)
+}
@@ -0,0 +1,213 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeySchema constrains the values of a key. A schema without allowed values nor pattern
+// accepts any value, MaxValues (when not zero) limits the number of values of the key.
+// Profile (when not empty) validates the key and its values instead of the project profile.
+type KeySchema struct {
+	Key           string   `json:"key"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	Pattern       string   `json:"pattern,omitempty"`
+	MaxValues     int      `json:"max_values,omitempty"`
+	Profile       string   `json:"profile,omitempty"`
+}
+
+// NewKeySchema validates the schema of the request, failing with InvalidArgument.
+func NewKeySchema(req *pb.KeySchema) (KeySchema, error) {
+	s := KeySchema{Key: Normalize(req.GetKey()), MaxValues: int(req.GetMaxValues())}
+	if s.Key == "" {
+		return s, status.Error(codes.InvalidArgument, "missing schema key")
+	}
+	profile, err := ProfileFromProto(req.GetProfile())
+	if err != nil {
+		return s, err
+	}
+	s.Profile = profile
+	switch req.GetType() {
+	case pb.KeySchema_TYPE_UNSPECIFIED, pb.KeySchema_TYPE_FREE_FORM:
+		if len(req.GetAllowedValues()) > 0 || req.GetPattern() != "" {
+			return s, status.Errorf(codes.InvalidArgument, "free-form schema of key %s can't have allowed values or a pattern", s.Key)
+		}
+	case pb.KeySchema_TYPE_ENUM:
+		for _, v := range req.GetAllowedValues() {
+			if v = Normalize(v); !slices.Contains(s.AllowedValues, v) {
+				s.AllowedValues = append(s.AllowedValues, v)
+			}
+		}
+		if len(s.AllowedValues) == 0 || req.GetPattern() != "" {
+			return s, status.Errorf(codes.InvalidArgument, "enum schema of key %s needs allowed values and no pattern", s.Key)
+		}
+	case pb.KeySchema_TYPE_REGEX:
+		if req.GetPattern() == "" || len(req.GetAllowedValues()) > 0 {
+			return s, status.Errorf(codes.InvalidArgument, "regex schema of key %s needs a pattern and no allowed values", s.Key)
+		}
+		s.Pattern = req.GetPattern()
+		if _, err := s.regexp(); err != nil {
+			return s, status.Errorf(codes.InvalidArgument, "invalid pattern of key %s: %v", s.Key, err)
+		}
+	default:
+		return s, status.Errorf(codes.InvalidArgument, "unknown schema type %v", req.GetType())
+	}
+	return s, nil
+}
+
+// ToProto returns the API form of the schema.
+func (s *KeySchema) ToProto() *pb.KeySchema {
+	schema := &pb.KeySchema{
+		Key:           s.Key,
+		Type:          pb.KeySchema_TYPE_FREE_FORM,
+		AllowedValues: s.AllowedValues,
+		Pattern:       s.Pattern,
+		MaxValues:     uint32(s.MaxValues),
+		Profile:       ProfileToProto(s.Profile),
+	}
+	if len(s.AllowedValues) > 0 {
+		schema.Type = pb.KeySchema_TYPE_ENUM
+	} else if s.Pattern != "" {
+		schema.Type = pb.KeySchema_TYPE_REGEX
+	}
+	return schema
+}
+
+// regexp compiles the pattern, which must match the whole value.
+func (s *KeySchema) regexp() (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + s.Pattern + ")$")
+}
+
+// checkValue returns why the schema rejects the value, or an empty string. The allowed values
+// are matched case-insensitively, the pattern is matched against the display form of the value
+// (as it was written), so that it can be case-sensitive.
+func (s *KeySchema) checkValue(v, display string) string {
+	if len(s.AllowedValues) > 0 && !slices.Contains(s.AllowedValues, v) {
+		return fmt.Sprintf("value %s is not allowed for key %s, allowed values are %s", display, s.Key, strings.Join(s.AllowedValues, ", "))
+	}
+	if s.Pattern != "" {
+		re, err := s.regexp()
+		if err != nil || !re.MatchString(display) {
+			return fmt.Sprintf("value %s of key %s does not match the pattern %s", display, s.Key, s.Pattern)
+		}
+	}
+	return ""
+}
+
+// checkKey returns why the schema rejects the values of the key, or an empty string.
+func (s *KeySchema) checkKey(k *Key) string {
+	if s.MaxValues > 0 && len(k.Values) > s.MaxValues {
+		return fmt.Sprintf("key %s accepts at most %d values, it has %d", s.Key, s.MaxValues, len(k.Values))
+	}
+	for _, v := range k.Values {
+		if reason := s.checkValue(v, k.DisplayValue(v)); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+// schema returns the schema of the key, nil if it is free-form.
+func (m *Metadata) schema(key string) *KeySchema {
+	i, found := slices.BinarySearchFunc(m.Schemas, key, func(s KeySchema, key string) int { return strings.Compare(s.Key, key) })
+	if !found {
+		return nil
+	}
+	return &m.Schemas[i]
+}
+
+// checkAdd fails with InvalidArgument if the schema of the key rejects the value, written as
+// display, new values are also rejected once the key holds as many values as its schema allows.
+func (m *Metadata) checkAdd(key, value, display string) error {
+	if reason := m.checkProfile(key, value); reason != "" {
+		return status.Error(codes.InvalidArgument, reason)
+	}
+	s := m.schema(key)
+	if s == nil {
+		return nil
+	}
+	if reason := s.checkValue(value, display); reason != "" {
+		return status.Error(codes.InvalidArgument, reason)
+	}
+	if s.MaxValues > 0 {
+		if i := m.indexOf(key); i >= 0 && !slices.Contains(m.Keys[i].Values, value) && len(m.Keys[i].Values) >= s.MaxValues {
+			return status.Errorf(codes.InvalidArgument, "key %s accepts at most %d values", key, s.MaxValues)
+		}
+	}
+	return nil
+}
+
+// checkKey fails with InvalidArgument if the validation profile or the schema of the key
+// rejects its name or values.
+func (m *Metadata) checkKey(key string) error {
+	if reason := m.violation(key); reason != "" {
+		return status.Error(codes.InvalidArgument, reason)
+	}
+	return nil
+}
+
+// SetSchema registers the schema of its key, replacing the previous one. It fails with
+// InvalidArgument if the validation profile of the key rejects the schema, and with
+// FailedPrecondition if the values already stored violate it.
+func (m *Metadata) SetSchema(schema KeySchema) error {
+	previous := m.Schemas
+	// the schemas are shared with the in-memory copy, replace them rather than updating in place
+	schemas := slices.DeleteFunc(slices.Clone(m.Schemas), func(s KeySchema) bool { return s.Key == schema.Key })
+	i, _ := slices.BinarySearchFunc(schemas, schema.Key, func(s KeySchema, key string) int { return strings.Compare(s.Key, key) })
+	m.Schemas = slices.Insert(schemas, i, schema)
+	if reason := m.checkProfile(schema.Key, schema.AllowedValues...); reason != "" {
+		m.Schemas = previous
+		return status.Error(codes.InvalidArgument, reason)
+	}
+	if reason := m.violation(schema.Key); reason != "" {
+		m.Schemas = previous
+		return status.Errorf(codes.FailedPrecondition, "the stored values violate the schema: %s", reason)
+	}
+	return nil
+}
+
+// renameSchema moves the schema of the key from to the key to, unless to has a schema of its
+// own, which is kept.
+func (m *Metadata) renameSchema(from, to string) {
+	s := m.schema(from)
+	if s == nil {
+		return
+	}
+	moved := *s
+	moved.Key = to
+	keep := m.schema(to) != nil
+	// the schemas are shared with the in-memory copy, replace them rather than updating in place
+	schemas := slices.DeleteFunc(slices.Clone(m.Schemas), func(s KeySchema) bool { return s.Key == from })
+	if !keep {
+		i, _ := slices.BinarySearchFunc(schemas, to, func(s KeySchema, key string) int { return strings.Compare(s.Key, key) })
+		schemas = slices.Insert(schemas, i, moved)
+	}
+	if len(schemas) == 0 {
+		schemas = nil
+	}
+	m.Schemas = schemas
+}
+
+// DeleteSchema removes the schema of the key, failing with NotFound if it has none.
+func (m *Metadata) DeleteSchema(key string) error {
+	key = Normalize(key)
+	if m.schema(key) == nil {
+		return status.Errorf(codes.NotFound, "key %s has no schema", key)
+	}
+	m.Schemas = slices.DeleteFunc(slices.Clone(m.Schemas), func(s KeySchema) bool { return s.Key == key })
+	if len(m.Schemas) == 0 {
+		m.Schemas = nil
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewKeySchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *pb.KeySchema
+		want     KeySchema
+		wantCode codes.Code
+	}{
+		{"free-form", &pb.KeySchema{Key: "Customer", MaxValues: 10}, KeySchema{Key: "customer", MaxValues: 10}, codes.OK},
+		{
+			"enum",
+			&pb.KeySchema{Key: "environment", Type: pb.KeySchema_TYPE_ENUM, AllowedValues: []string{"dev", "Staging", "prod", "dev"}},
+			KeySchema{Key: "environment", AllowedValues: []string{"dev", "staging", "prod"}},
+			codes.OK,
+		},
+		{"regex", &pb.KeySchema{Key: "zone", Type: pb.KeySchema_TYPE_REGEX, Pattern: "zone-[0-9]+"}, KeySchema{Key: "zone", Pattern: "zone-[0-9]+"}, codes.OK},
+		{"missing-key", &pb.KeySchema{}, KeySchema{}, codes.InvalidArgument},
+		{"free-form-with-values", &pb.KeySchema{Key: "k", AllowedValues: []string{"v"}}, KeySchema{}, codes.InvalidArgument},
+		{"enum-without-values", &pb.KeySchema{Key: "k", Type: pb.KeySchema_TYPE_ENUM}, KeySchema{}, codes.InvalidArgument},
+		{"invalid-pattern", &pb.KeySchema{Key: "k", Type: pb.KeySchema_TYPE_REGEX, Pattern: "zone-("}, KeySchema{}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKeySchema(tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestKeySchema_ToProto(t *testing.T) {
+	assert.Equal(t, pb.KeySchema_TYPE_FREE_FORM, (&KeySchema{Key: "k"}).ToProto().Type)
+	assert.Equal(t, pb.KeySchema_TYPE_ENUM, (&KeySchema{Key: "k", AllowedValues: []string{"v"}}).ToProto().Type)
+	assert.Equal(t, pb.KeySchema_TYPE_REGEX, (&KeySchema{Key: "k", Pattern: "v"}).ToProto().Type)
+}
+
+func TestMetadata_createOrUpdate_Schema(t *testing.T) {
+	m := &Metadata{}
+	require.NoError(t, m.SetSchema(KeySchema{Key: "environment", AllowedValues: []string{"dev", "staging", "prod"}, MaxValues: 2}))
+	require.NoError(t, m.SetSchema(KeySchema{Key: "zone", Pattern: "zone-[0-9]+"}))
+	require.NoError(t, m.SetSchema(KeySchema{Key: "site", Pattern: "[A-Z]+"}))
+
+	tests := []struct {
+		name     string
+		md       *pb.Metadata
+		wantCode codes.Code
+	}{
+		{"allowed", &pb.Metadata{Key: "Environment", Value: "Dev"}, codes.OK},
+		{"not-allowed", &pb.Metadata{Key: "environment", Value: "qa"}, codes.InvalidArgument},
+		{"second", &pb.Metadata{Key: "environment", Value: "prod"}, codes.OK},
+		{"already-stored", &pb.Metadata{Key: "environment", Value: "dev"}, codes.OK},
+		{"too-many", &pb.Metadata{Key: "environment", Value: "staging"}, codes.InvalidArgument},
+		{"matching", &pb.Metadata{Key: "zone", Value: "zone-1"}, codes.OK},
+		{"partial-match", &pb.Metadata{Key: "zone", Value: "zone-1a"}, codes.InvalidArgument},
+		// the pattern is matched against the value as written
+		{"case-sensitive", &pb.Metadata{Key: "zone", Value: "Zone-2"}, codes.InvalidArgument},
+		{"uppercase", &pb.Metadata{Key: "site", Value: "NYC"}, codes.OK},
+		{"lowercase", &pb.Metadata{Key: "site", Value: "nyc"}, codes.InvalidArgument},
+		{"free-form", &pb.Metadata{Key: "other", Value: "anything"}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := m.createOrUpdate(tt.md)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+	assert.Equal(t, []string{"dev", "prod"}, m.Keys[0].Values)
+}
+
+func TestMetadata_SetSchema(t *testing.T) {
+	m := &Metadata{Keys: []Key{{Name: "environment", Values: []string{"dev", "qa"}}}}
+	schemas := m.Schemas
+
+	err := m.SetSchema(KeySchema{Key: "environment", AllowedValues: []string{"dev", "prod"}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	err = m.SetSchema(KeySchema{Key: "environment", MaxValues: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, m.Schemas)
+
+	require.NoError(t, m.SetSchema(KeySchema{Key: "zone", MaxValues: 1}))
+	require.NoError(t, m.SetSchema(KeySchema{Key: "environment", AllowedValues: []string{"dev", "qa", "prod"}}))
+	require.NoError(t, m.SetSchema(KeySchema{Key: "environment", MaxValues: 3}))
+	assert.Equal(t, []KeySchema{{Key: "environment", MaxValues: 3}, {Key: "zone", MaxValues: 1}}, m.Schemas)
+	assert.Empty(t, schemas)
+
+	// renames and merges must satisfy the schema of their target
+	_, err = m.mergeValues("environment", []string{"dev"}, "staging")
+	assert.NoError(t, err)
+	m.Keys = append(m.Keys, Key{Name: "zones", Values: []string{"z1", "z2"}})
+	_, err = m.renameKey("zones", "zone")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the stored values are checked as they were written
+	m.Keys = append(m.Keys, Key{Name: "site", Values: []string{"nyc"}, DisplayValues: map[string]string{"nyc": "NYC"}})
+	require.NoError(t, m.SetSchema(KeySchema{Key: "site", Pattern: "[A-Z]+"}))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(m.SetSchema(KeySchema{Key: "site", Pattern: "[a-z]+"})))
+	require.NoError(t, m.DeleteSchema("site"))
+
+	require.NoError(t, m.DeleteSchema("Zone"))
+	assert.Equal(t, codes.NotFound, status.Code(m.DeleteSchema("zone")))
+	assert.Equal(t, []KeySchema{{Key: "environment", MaxValues: 3}}, m.Schemas)
+}
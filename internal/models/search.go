@@ -0,0 +1,155 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+)
+
+// searchMatch is a candidate matching a query, with what it takes to rank it.
+type searchMatch struct {
+	match     *pb.SearchMatch
+	candidate string
+	// position of the query in the candidate, for the substring matches
+	position int
+}
+
+// Search returns the keys and values matching the query, best matches first: exact matches,
+// then prefix, substring and fuzzy ones (by edit distance), up to mode, shorter candidates first.
+// With a key, only the values of that key are searched. The matches are normalized, with their
+// display forms when they differ.
+func (m *Metadata) Search(query, key string, mode pb.SearchMetadataRequest_SearchMode, limit int) []*pb.SearchMatch {
+	query, key = Normalize(query), Normalize(key)
+	if mode == pb.SearchMetadataRequest_SEARCH_MODE_UNSPECIFIED {
+		mode = pb.SearchMetadataRequest_SEARCH_MODE_FUZZY
+	}
+
+	var matches []searchMatch
+	add := func(md *pb.Metadata, display *pb.Metadata, candidate string) {
+		if t, distance, position, ok := match(query, candidate, mode); ok {
+			sm := &pb.SearchMatch{Metadata: md, Type: t, Distance: uint32(distance)}
+			if display.Key != md.Key {
+				sm.DisplayKey = display.Key
+			}
+			if display.Value != md.Value {
+				sm.DisplayValue = display.Value
+			}
+			matches = append(matches, searchMatch{
+				match:     sm,
+				candidate: candidate,
+				position:  position,
+			})
+		}
+	}
+	for _, k := range m.Keys {
+		if key != "" && k.Name != key {
+			continue
+		}
+		if key == "" && len(k.Values) > 0 {
+			add(&pb.Metadata{Key: k.Name}, &pb.Metadata{Key: k.DisplayName()}, k.Name)
+		}
+		for _, v := range k.Values {
+			add(&pb.Metadata{Key: k.Name, Value: v}, &pb.Metadata{Key: k.DisplayName(), Value: k.DisplayValue(v)}, v)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.match.Type != b.match.Type:
+			return a.match.Type < b.match.Type
+		case a.match.Distance != b.match.Distance:
+			return a.match.Distance < b.match.Distance
+		case a.position != b.position:
+			return a.position < b.position
+		case len(a.candidate) != len(b.candidate):
+			return len(a.candidate) < len(b.candidate)
+		default:
+			return a.candidate < b.candidate
+		}
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	result := make([]*pb.SearchMatch, 0, len(matches))
+	for _, sm := range matches {
+		result = append(result, sm.match)
+	}
+	return result
+}
+
+// match tells how the candidate matches the query, with the edit distance of the fuzzy matches
+// and the position of the substring ones.
+func match(query, candidate string, mode pb.SearchMetadataRequest_SearchMode) (pb.SearchMatch_MatchType, int, int, bool) {
+	switch {
+	case candidate == query:
+		return pb.SearchMatch_MATCH_TYPE_EXACT, 0, 0, true
+	case strings.HasPrefix(candidate, query):
+		return pb.SearchMatch_MATCH_TYPE_PREFIX, 0, 0, true
+	case mode == pb.SearchMetadataRequest_SEARCH_MODE_PREFIX:
+		return 0, 0, 0, false
+	}
+	if i := strings.Index(candidate, query); i > 0 {
+		return pb.SearchMatch_MATCH_TYPE_SUBSTRING, 0, i, true
+	}
+	if mode != pb.SearchMetadataRequest_SEARCH_MODE_FUZZY {
+		return 0, 0, 0, false
+	}
+
+	q, c := []rune(query), []rune(candidate)
+	maxDistance := fuzzyDistance(len(q))
+	if maxDistance == 0 {
+		return 0, 0, 0, false
+	}
+	// the query is usually the start of what the user is typing, compare it to the start of the candidate too
+	distance := editDistance(q, c)
+	if len(c) > len(q) {
+		distance = min(distance, editDistance(q, c[:len(q)]))
+	}
+	if distance > maxDistance {
+		return 0, 0, 0, false
+	}
+	return pb.SearchMatch_MATCH_TYPE_FUZZY, distance, 0, true
+}
+
+// fuzzyDistance returns the number of edits allowed for a fuzzy match of a query of that length.
+func fuzzyDistance(length int) int {
+	switch {
+	case length < 3:
+		return 0
+	case length <= 5:
+		return 1
+	case length <= 10:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,123 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"testing"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_Search(t *testing.T) {
+	m := &Metadata{Keys: []Key{
+		{Name: "customer", Values: []string{"culvers", "costco", "acme-culver", "kulvers"}},
+		{Name: "cluster", Values: []string{"cust-1"}},
+		{Name: "empty", Values: []string{}},
+	}}
+
+	type result struct {
+		key, value string
+		t          pb.SearchMatch_MatchType
+		distance   uint32
+	}
+	exact, prefix, substring, fuzzy := pb.SearchMatch_MATCH_TYPE_EXACT, pb.SearchMatch_MATCH_TYPE_PREFIX,
+		pb.SearchMatch_MATCH_TYPE_SUBSTRING, pb.SearchMatch_MATCH_TYPE_FUZZY
+
+	tests := []struct {
+		name  string
+		query string
+		key   string
+		mode  pb.SearchMetadataRequest_SearchMode
+		limit int
+		want  []result
+	}{
+		{
+			"ranked", "Culver", "", pb.SearchMetadataRequest_SEARCH_MODE_UNSPECIFIED, 10,
+			[]result{
+				{"customer", "culvers", prefix, 0},
+				{"customer", "acme-culver", substring, 0},
+				{"customer", "kulvers", fuzzy, 1},
+			},
+		},
+		{
+			"keys-and-values", "cus", "", pb.SearchMetadataRequest_SEARCH_MODE_PREFIX, 10,
+			[]result{
+				{"cluster", "cust-1", prefix, 0},
+				{"customer", "", prefix, 0},
+			},
+		},
+		{
+			"exact-first", "culvers", "customer", pb.SearchMetadataRequest_SEARCH_MODE_FUZZY, 10,
+			[]result{
+				{"customer", "culvers", exact, 0},
+				{"customer", "kulvers", fuzzy, 1},
+			},
+		},
+		{
+			"prefix-only", "culver", "customer", pb.SearchMetadataRequest_SEARCH_MODE_PREFIX, 10,
+			[]result{{"customer", "culvers", prefix, 0}},
+		},
+		{
+			"substring", "culver", "customer", pb.SearchMetadataRequest_SEARCH_MODE_SUBSTRING, 10,
+			[]result{{"customer", "culvers", prefix, 0}, {"customer", "acme-culver", substring, 0}},
+		},
+		{
+			"typo-while-typing", "culvr", "customer", pb.SearchMetadataRequest_SEARCH_MODE_FUZZY, 10,
+			[]result{{"customer", "culvers", fuzzy, 1}},
+		},
+		{
+			"short-queries-are-not-fuzzy", "cx", "", pb.SearchMetadataRequest_SEARCH_MODE_FUZZY, 10,
+			nil,
+		},
+		{
+			"limit", "c", "customer", pb.SearchMetadataRequest_SEARCH_MODE_UNSPECIFIED, 2,
+			[]result{{"customer", "costco", prefix, 0}, {"customer", "culvers", prefix, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []result
+			for _, sm := range m.Search(tt.query, tt.key, tt.mode, tt.limit) {
+				got = append(got, result{sm.Metadata.Key, sm.Metadata.Value, sm.Type, sm.Distance})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMetadata_SearchDisplay(t *testing.T) {
+	m := &Metadata{Keys: []Key{
+		{Name: "customer", Display: "Customer", Values: []string{"culvers", "acme"}, DisplayValues: map[string]string{"culvers": "Culvers"}},
+	}}
+	got := m.Search("cu", "", pb.SearchMetadataRequest_SEARCH_MODE_PREFIX, 10)
+	assert.Equal(t, []*pb.SearchMatch{
+		{Metadata: &pb.Metadata{Key: "customer", Value: "culvers"}, Type: pb.SearchMatch_MATCH_TYPE_PREFIX, DisplayKey: "Customer", DisplayValue: "Culvers"},
+		{Metadata: &pb.Metadata{Key: "customer"}, Type: pb.SearchMatch_MATCH_TYPE_PREFIX, DisplayKey: "Customer"},
+	}, got)
+
+	// the value keeps its casing
+	got = m.Search("acme", "customer", pb.SearchMetadataRequest_SEARCH_MODE_PREFIX, 10)
+	assert.Equal(t, []*pb.SearchMatch{
+		{Metadata: &pb.Metadata{Key: "customer", Value: "acme"}, Type: pb.SearchMatch_MATCH_TYPE_EXACT, DisplayKey: "Customer"},
+	}, got)
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"culvers", "kulvers", 1},
+		{"héllo", "hello", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, editDistance([]rune(tt.a), []rune(tt.b)), "%s/%s", tt.a, tt.b)
+	}
+}
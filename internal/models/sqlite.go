@@ -0,0 +1,278 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	// pure Go SQLite driver, registered as "sqlite"
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteFileName is the name of the SQLite database created in the persist folder.
+const SQLiteFileName = "metadata.sqlite"
+
+// SQLiteStore keeps the metadata in a SQLite database with one table for the
+// projects, one for their keys and one for the values of each key, so that the
+// data can be inspected with plain SQL, e.g.:
+//
+//	SELECT k.project_id, k.name, v.value FROM keys k
+//	JOIN key_values v ON v.project_id = k.project_id AND v.key_name = k.name;
+type SQLiteStore struct {
+	db       *sql.DB
+	fileName string
+}
+
+// NewSQLiteStore opens (or creates) the SQLite database at fileName, Migrate brings its schema up to date.
+func NewSQLiteStore(fileName string) (*SQLiteStore, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", fileName)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		log.Errorf("Error while opening SQLite database %s: %v", fileName, err)
+		return nil, err
+	}
+	// SQLite allows a single writer, serialize the access from this process
+	db.SetMaxOpenConns(1)
+
+	log.Infof("Using SQLite database %s", fileName)
+	return &SQLiteStore{db: db, fileName: fileName}, nil
+}
+
+// migrateSchema brings the schema of the database up to date, it's called by Migrate before the store is used.
+func (s *SQLiteStore) migrateSchema() error {
+	if err := MigrateSchema(s.db); err != nil {
+		return fmt.Errorf("unable to migrate the schema of the SQLite database %s: %w", s.fileName, err)
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Load(projectId string) (*MetadataStoreV1, error) {
+	m := &MetadataStoreV1{}
+	err := s.db.QueryRow(`SELECT version, revision, profile FROM projects WHERE id = ?`, projectId).Scan(&m.Version, &m.Revision, &m.Profile)
+	if err == sql.ErrNoRows {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(`
+		SELECT k.name, k.display_name, v.value, v.display_value, v.created_at, v.last_seen_at FROM keys k
+		LEFT JOIN key_values v ON v.project_id = k.project_id AND v.key_name = k.name
+		WHERE k.project_id = ?
+		ORDER BY k.position, v.position`, projectId)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var name string
+		var displayName, value, displayValue sql.NullString
+		var createdAt, lastSeenAt sql.NullInt64
+		if err := rows.Scan(&name, &displayName, &value, &displayValue, &createdAt, &lastSeenAt); err != nil {
+			return nil, err
+		}
+		if len(m.Keys) == 0 || m.Keys[len(m.Keys)-1].Name != name {
+			m.Keys = append(m.Keys, Key{Name: name, Values: []string{}, Display: displayName.String})
+		}
+		if value.Valid {
+			key := &m.Keys[len(m.Keys)-1]
+			key.AddValue(value.String)
+			key.setDisplayValue(value.String, displayValue.String)
+			if createdAt.Valid && lastSeenAt.Valid {
+				if key.Times == nil {
+					key.Times = map[string]ValueTimes{}
+				}
+				key.Times[value.String] = ValueTimes{
+					CreatedAt:  time.Unix(createdAt.Int64, 0).UTC(),
+					LastSeenAt: time.Unix(lastSeenAt.Int64, 0).UTC(),
+				}
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := s.loadValueSets(projectId, m); err != nil {
+		return nil, err
+	}
+	return m, s.loadSchemas(projectId, m)
+}
+
+func (s *SQLiteStore) loadSchemas(projectId string, m *MetadataStoreV1) error {
+	rows, err := s.db.Query(`SELECT key_name, allowed_values, pattern, max_values, profile FROM key_schemas
+		WHERE project_id = ? ORDER BY key_name`, projectId)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var schema KeySchema
+		var allowed string
+		if err := rows.Scan(&schema.Key, &allowed, &schema.Pattern, &schema.MaxValues, &schema.Profile); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(allowed), &schema.AllowedValues); err != nil {
+			return err
+		}
+		if len(schema.AllowedValues) == 0 {
+			schema.AllowedValues = nil
+		}
+		m.Schemas = append(m.Schemas, schema)
+	}
+	return rows.Err()
+}
+
+// valueSets lists the tables holding a set of names per value, and the Key field they are loaded in.
+var valueSets = []struct {
+	table, column string
+	field         func(k *Key) *map[string][]string
+}{
+	{"value_owners", "owner", func(k *Key) *map[string][]string { return &k.Owners }},
+	{"value_sources", "source", func(k *Key) *map[string][]string { return &k.Sources }},
+}
+
+func (s *SQLiteStore) loadValueSets(projectId string, m *MetadataStoreV1) error {
+	keys := make(map[string]*Key, len(m.Keys))
+	for i := range m.Keys {
+		keys[m.Keys[i].Name] = &m.Keys[i]
+	}
+
+	for _, vs := range valueSets {
+		query := fmt.Sprintf(`SELECT key_name, value, %[1]s FROM %[2]s WHERE project_id = ?
+			ORDER BY key_name, value, %[1]s`, vs.column, vs.table)
+		if err := s.loadValueSet(query, projectId, keys, vs.field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteStore) loadValueSet(query, projectId string, keys map[string]*Key, field func(k *Key) *map[string][]string) error {
+	rows, err := s.db.Query(query, projectId)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var name, value, member string
+		if err := rows.Scan(&name, &value, &member); err != nil {
+			return err
+		}
+		sets := field(keys[name])
+		if *sets == nil {
+			*sets = map[string][]string{}
+		}
+		(*sets)[value] = append((*sets)[value], member)
+	}
+	return rows.Err()
+}
+
+// Save replaces the keys and values of the project in a single transaction.
+func (s *SQLiteStore) Save(projectId string, data *MetadataStoreV1) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`INSERT INTO projects (id, version, revision, profile) VALUES (?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET version = excluded.version, revision = excluded.revision, profile = excluded.profile`,
+		projectId, data.Version, data.Revision, data.Profile)
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`DELETE FROM key_schemas WHERE project_id = ?`, projectId); err != nil {
+		return err
+	}
+	for _, schema := range data.Schemas {
+		allowed, err := json.Marshal(schema.AllowedValues)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO key_schemas (project_id, key_name, allowed_values, pattern, max_values, profile)
+			VALUES (?, ?, ?, ?, ?, ?)`, projectId, schema.Key, string(allowed), schema.Pattern, schema.MaxValues, schema.Profile)
+		if err != nil {
+			return err
+		}
+	}
+	// values are removed with their key (ON DELETE CASCADE)
+	if _, err = tx.Exec(`DELETE FROM keys WHERE project_id = ?`, projectId); err != nil {
+		return err
+	}
+
+	for i, k := range data.Keys {
+		_, err = tx.Exec(`INSERT INTO keys (project_id, name, position, display_name) VALUES (?, ?, ?, ?)`,
+			projectId, k.Name, i, sql.NullString{String: k.Display, Valid: k.Display != ""})
+		if err != nil {
+			return err
+		}
+		for j, v := range k.Values {
+			var createdAt, lastSeenAt sql.NullInt64
+			if times, ok := k.Times[v]; ok {
+				createdAt = sql.NullInt64{Int64: times.CreatedAt.Unix(), Valid: true}
+				lastSeenAt = sql.NullInt64{Int64: times.LastSeenAt.Unix(), Valid: true}
+			}
+			display, hasDisplay := k.DisplayValues[v]
+			_, err = tx.Exec(`INSERT INTO key_values (project_id, key_name, value, position, created_at, last_seen_at, display_value)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`, projectId, k.Name, v, j, createdAt, lastSeenAt, sql.NullString{String: display, Valid: hasDisplay})
+			if err != nil {
+				return err
+			}
+			for _, vs := range valueSets {
+				insert := fmt.Sprintf(`INSERT INTO %s (project_id, key_name, value, %s) VALUES (?, ?, ?, ?)`, vs.table, vs.column)
+				for _, member := range (*vs.field(&k))[v] {
+					if _, err = tx.Exec(insert, projectId, k.Name, v, member); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLiteStore) DeleteProject(projectId string) error {
+	res, err := s.db.Exec(`DELETE FROM projects WHERE id = ?`, projectId)
+	if err != nil {
+		log.Errorf("Failed to delete project: %v", err)
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		log.Debugf("Project %s doesn't exist", projectId)
+		return nil
+	}
+	log.Infof("Successfully deleted project %s", projectId)
+	return nil
+}
+
+func (s *SQLiteStore) Projects() ([]string, error) {
+	rows, err := s.db.Query(`SELECT id FROM projects ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var projects []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		projects = append(projects, id)
+	}
+	return projects, rows.Err()
+}
+
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,263 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSQLiteStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+	s, err := NewSQLiteStore(path.Join(t.TempDir(), SQLiteFileName))
+	require.NoError(t, err)
+	require.NoError(t, s.migrateSchema())
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestSQLiteStore_SaveLoad(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	seen := created.Add(36 * time.Hour)
+	tests := []struct {
+		name  string
+		saves []*MetadataStoreV1
+		want  *MetadataStoreV1
+	}{
+		{
+			"single-save",
+			[]*MetadataStoreV1{expectedMetadataV1},
+			expectedMetadataV1,
+		},
+		{
+			"update",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}},
+					{Name: "gone", Values: []string{"x"}},
+				}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "new", Values: []string{"n"}},
+					{Name: "foo", Values: []string{"rab", "baz"}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "new", Values: []string{"n"}},
+				{Name: "foo", Values: []string{"rab", "baz"}},
+			}}},
+		},
+		{
+			"revision",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1", Revision: 41}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}},
+				{VersionedStore{Version: "v1", Revision: 42}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"rab"}}}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1", Revision: 42}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"rab"}}}}},
+		},
+		{
+			"owners-and-sources",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o1", "o2"}, "rab": {"o1"}}},
+				}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o2"}}, Sources: map[string][]string{"rab": {"app-orch", "edge-infra"}}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Owners: map[string][]string{"bar": {"o2"}}, Sources: map[string][]string{"rab": {"app-orch", "edge-infra"}}},
+			}}},
+		},
+		{
+			"times",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Times: map[string]ValueTimes{"bar": {CreatedAt: created, LastSeenAt: seen}}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Times: map[string]ValueTimes{"bar": {CreatedAt: created, LastSeenAt: seen}}},
+			}}},
+		},
+		{
+			"schemas",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "foo", AllowedValues: []string{"bar", "rab"}}, {Key: "size", MaxValues: 2}},
+				}},
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "env", Pattern: "dev|prod", MaxValues: 1}, {Key: "foo", AllowedValues: []string{"bar", "rab"}}},
+				}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{
+				Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+				Schemas: []KeySchema{{Key: "env", Pattern: "dev|prod", MaxValues: 1}, {Key: "foo", AllowedValues: []string{"bar", "rab"}}},
+			}},
+		},
+		{
+			"display",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}}},
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+					{Name: "foo", Values: []string{"bar", "rab"}, Display: "Foo", DisplayValues: map[string]string{"rab": "RAB"}},
+				}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{
+				{Name: "foo", Values: []string{"bar", "rab"}, Display: "Foo", DisplayValues: map[string]string{"rab": "RAB"}},
+			}}},
+		},
+		{
+			"profile",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{"bar"}}}, Profile: ProfileExtended}},
+				{VersionedStore{Version: "v1"}, Metadata{
+					Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+					Schemas: []KeySchema{{Key: "foo", Profile: ProfileK8sLabel}},
+				}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{
+				Keys:    []Key{{Name: "foo", Values: []string{"bar"}}},
+				Schemas: []KeySchema{{Key: "foo", Profile: ProfileK8sLabel}},
+			}},
+		},
+		{
+			"empty-key",
+			[]*MetadataStoreV1{
+				{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{}}}}},
+			},
+			&MetadataStoreV1{VersionedStore{Version: "v1"}, Metadata{Keys: []Key{{Name: "foo", Values: []string{}}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSQLiteStore(t)
+			for _, data := range tt.saves {
+				require.NoError(t, s.Save(projectId, data))
+			}
+
+			got, err := s.Load(projectId)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLiteStore_Projects(t *testing.T) {
+	s := newTestSQLiteStore(t)
+	require.NoError(t, s.Save("p1", expectedMetadataV1))
+	require.NoError(t, s.Save("p2", expectedMetadataV1))
+
+	projects, err := s.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2"}, projects)
+
+	assert.NoError(t, s.DeleteProject("p1"))
+	assert.NoError(t, s.DeleteProject("missing"))
+
+	projects, err = s.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p2"}, projects)
+
+	got, err := s.Load("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, &MetadataStoreV1{}, got)
+
+	// keys and values are removed with the project
+	var count int
+	require.NoError(t, s.db.QueryRow(`SELECT COUNT(*) FROM key_values WHERE project_id = 'p1'`).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestSQLiteStore_QueryableSchema(t *testing.T) {
+	s := newTestSQLiteStore(t)
+	require.NoError(t, s.Save(projectId, expectedMetadataV1))
+
+	rows, err := s.db.Query(`SELECT k.name, v.value FROM keys k
+		JOIN key_values v ON v.project_id = k.project_id AND v.key_name = k.name
+		WHERE k.project_id = ? ORDER BY v.value`, projectId)
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+
+	var got []string
+	for rows.Next() {
+		var k, v string
+		require.NoError(t, rows.Scan(&k, &v))
+		got = append(got, k+"="+v)
+	}
+	assert.Equal(t, []string{"foo=bar", "foo=rab"}, got)
+}
+
+func TestMigrateSchema(t *testing.T) {
+	fileName := path.Join(t.TempDir(), SQLiteFileName)
+	s, err := NewSQLiteStore(fileName)
+	require.NoError(t, err)
+	require.NoError(t, Migrate(t.TempDir(), "", s, ""))
+	require.NoError(t, s.Save(projectId, expectedMetadataV1))
+
+	// migrating an up to date database is a no-op
+	assert.NoError(t, MigrateSchema(s.db))
+	var version int
+	require.NoError(t, s.db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version))
+	assert.Equal(t, len(schemaMigrations), version)
+
+	// a database created by a newer release is rejected
+	_, err = s.db.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version+1)
+	require.NoError(t, err)
+	assert.Error(t, MigrateSchema(s.db))
+	require.NoError(t, s.Close())
+
+	s, err = NewSQLiteStore(fileName)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	assert.ErrorContains(t, Migrate(t.TempDir(), "", s, ""), "newer than the supported version")
+}
+
+func TestMigrateSchema_failedStep(t *testing.T) {
+	db, err := sql.Open("sqlite", path.Join(t.TempDir(), SQLiteFileName))
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	_, err = db.Exec(`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY, applied_at TEXT)`)
+	require.NoError(t, err)
+	require.NoError(t, migrateSchemaVersion(db, 1))
+
+	// the column added by version 2 already exists: the step fails and is not recorded
+	_, err = db.Exec(`ALTER TABLE projects ADD COLUMN revision INTEGER`)
+	require.NoError(t, err)
+	err = MigrateSchema(db)
+	assert.ErrorContains(t, err, "schema migration from version 1 to 2")
+	var version int
+	require.NoError(t, db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version))
+	assert.Equal(t, 1, version)
+}
+
+func TestMigrate_SQLite(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, os.WriteFile(getFilename(folder, "p1"), []byte(jsonmetadataV1), 0644))
+
+	s, err := NewSQLiteStore(path.Join(folder, SQLiteFileName))
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	// the schema is migrated and the files imported by the same entry point
+	require.NoError(t, Migrate(folder, path.Join(folder, "metadata.json"), s, ""))
+	var version int
+	require.NoError(t, s.db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version))
+	assert.Equal(t, len(schemaMigrations), version)
+
+	got, err := s.Load("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+	_, err = os.Stat(getFilename(folder, "p1") + ".migrated")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,444 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Store persists the metadata of each project.
+type Store interface {
+	// Load returns the metadata stored for the project, or an empty store if there is none.
+	Load(projectId string) (*MetadataStoreV1, error)
+	// Save replaces the metadata stored for the project.
+	Save(projectId string, data *MetadataStoreV1) error
+	// DeleteProject removes all the metadata stored for the project.
+	DeleteProject(projectId string) error
+	// Projects lists the projects that have metadata stored.
+	Projects() ([]string, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+const (
+	// BackendJSON stores each project in its own JSON file.
+	BackendJSON = "json"
+	// BackendBolt stores every project in a single bbolt database.
+	BackendBolt = "bbolt"
+	// BackendSQLite stores every project in a single SQLite database.
+	BackendSQLite = "sqlite"
+)
+
+// OpenStore opens the Store for the requested backend, using persistFolder to hold its data.
+// The databases of a new installation are created along with the folder.
+func OpenStore(backend, persistFolder string) (Store, error) {
+	switch backend {
+	case "", BackendJSON:
+		return NewFileStore(persistFolder), nil
+	}
+	if err := os.MkdirAll(persistFolder, 0700); err != nil {
+		return nil, err
+	}
+	switch backend {
+	case BackendBolt:
+		return NewBoltStore(filepath.Join(persistFolder, BoltFileName))
+	case BackendSQLite:
+		return NewSQLiteStore(filepath.Join(persistFolder, SQLiteFileName))
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
+
+// FileStore keeps each project in its own metadata-<project>.json file.
+type FileStore struct {
+	folder string
+}
+
+// NewFileStore returns a Store backed by the JSON files in persistFolder.
+func NewFileStore(persistFolder string) *FileStore {
+	return &FileStore{folder: persistFolder}
+}
+
+func (f *FileStore) Load(projectId string) (*MetadataStoreV1, error) {
+	return LoadMetadataV1(f.folder, projectId)
+}
+
+func (f *FileStore) Save(projectId string, data *MetadataStoreV1) error {
+	return SaveMetadataV1(data, f.folder, projectId)
+}
+
+func (f *FileStore) DeleteProject(projectId string) error {
+	return DeleteProject(f.folder, projectId)
+}
+
+func (f *FileStore) Projects() ([]string, error) {
+	entries, err := os.ReadDir(f.folder)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var projects []string
+	seen := map[string]bool{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		// the projects with a quarantined file are listed, to be reported as corrupted
+		if i := strings.Index(name, quarantineSuffix); i > 0 {
+			name = name[:i]
+		}
+		if projectId, ok := projectFromFilename(name); ok && !seen[projectId] {
+			seen[projectId] = true
+			projects = append(projects, projectId)
+		}
+	}
+	return projects, nil
+}
+
+func (f *FileStore) Close() error {
+	return nil
+}
+
+func projectFromFilename(name string) (string, bool) {
+	if !strings.HasPrefix(name, "metadata-") || filepath.Ext(name) != ".json" {
+		return "", false
+	}
+	projectId := strings.TrimSuffix(strings.TrimPrefix(name, "metadata-"), ".json")
+	return projectId, projectId != ""
+}
+
+// projectIndex is the in-memory copy of a project together with a
+// key → value set index used for constant time lookups.
+type projectIndex struct {
+	data   *MetadataStoreV1
+	keys   map[string]*Key
+	values map[string]map[string]struct{}
+}
+
+func newProjectIndex(data *MetadataStoreV1) *projectIndex {
+	idx := &projectIndex{
+		data:   data,
+		keys:   make(map[string]*Key, len(data.Keys)),
+		values: make(map[string]map[string]struct{}, len(data.Keys)),
+	}
+	for i, k := range data.Keys {
+		set := make(map[string]struct{}, len(k.Values))
+		for _, v := range k.Values {
+			set[v] = struct{}{}
+		}
+		idx.keys[k.Name] = &data.Keys[i]
+		idx.values[k.Name] = set
+	}
+	return idx
+}
+
+// CommitFunc is notified of every change committed by a MemoryStore, with the project
+// metadata before and after the change. It runs while the project is locked, so the
+// notifications of a project are delivered in commit order; it must not modify the data.
+type CommitFunc func(projectId string, before, after *MetadataStoreV1)
+
+// MemoryStore serves reads from an in-memory copy of every project and
+// writes changes through to a backing Store.
+// All the projects are loaded from the backend once, when the store is created.
+// Projects whose data is corrupted keep failing with the load error until they are
+// saved again or deleted, rather than being served as empty.
+// The revision of a deleted project is kept in memory, so that it keeps increasing once the project
+// is created again and the revisions seen before the deletion never match the new data.
+type MemoryStore struct {
+	backend   Store
+	mu        sync.RWMutex
+	projects  map[string]*projectIndex
+	corrupted map[string]error
+	// tombstones holds the revision of the deleted projects, until they are saved again
+	tombstones map[string]uint64
+	// txLocks holds the lock of each project with transactions running or waiting, guarded by txMu
+	txMu     sync.Mutex
+	txLocks  map[string]*projectLock
+	onCommit CommitFunc
+}
+
+// NewMemoryStore loads every project from backend and returns a MemoryStore on top of it.
+func NewMemoryStore(backend Store) (*MemoryStore, error) {
+	projects, err := backend.Projects()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MemoryStore{
+		backend:    backend,
+		projects:   make(map[string]*projectIndex, len(projects)),
+		corrupted:  map[string]error{},
+		tombstones: map[string]uint64{},
+		txLocks:    map[string]*projectLock{},
+	}
+	for _, projectId := range projects {
+		data, err := backend.Load(projectId)
+		if status.Code(err) == codes.DataLoss {
+			log.Errorf("Metadata of project %s is corrupted: %v", projectId, err)
+			m.corrupted[projectId] = err
+			continue
+		}
+		if err != nil {
+			log.Errorf("Unable to load metadata for project %s: %v", projectId, err)
+			return nil, err
+		}
+		// the keys left without values by earlier releases are not served
+		data.dropEmptyKeys()
+		m.projects[projectId] = newProjectIndex(data)
+	}
+	log.Infof("Loaded metadata for %d project(s)", len(m.projects))
+	return m, nil
+}
+
+// Load returns a copy of the project metadata, callers are free to modify it.
+func (m *MemoryStore) Load(projectId string) (*MetadataStoreV1, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.corrupted[projectId]; err != nil {
+		return nil, err
+	}
+	idx, ok := m.projects[projectId]
+	if !ok {
+		return m.empty(projectId), nil
+	}
+	return idx.data.clone(), nil
+}
+
+// Save flushes data to the backend and, once persisted, replaces the in-memory copy.
+func (m *MemoryStore) Save(projectId string, data *MetadataStoreV1) error {
+	if err := m.backend.Save(projectId, data); err != nil {
+		return err
+	}
+
+	idx := newProjectIndex(data.clone())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.projects[projectId] = idx
+	delete(m.corrupted, projectId)
+	delete(m.tombstones, projectId)
+	return nil
+}
+
+// empty returns the metadata of a project without any stored, at the revision it was deleted
+// at if it was. m.mu must be held.
+func (m *MemoryStore) empty(projectId string) *MetadataStoreV1 {
+	return &MetadataStoreV1{VersionedStore: VersionedStore{Revision: m.tombstones[projectId]}}
+}
+
+// Update runs a read-modify-write transaction on the project: fn receives a copy of the
+// current metadata and, if it returns no error and changed the metadata, the modified copy
+// is saved with the next revision. The metadata resulting from the transaction is returned,
+// callers must not modify it.
+// Transactions on the same project are serialized, transactions on different projects run concurrently.
+func (m *MemoryStore) Update(projectId string, fn func(data *MetadataStoreV1) error) (*MetadataStoreV1, error) {
+	unlock := m.lockProject(projectId)
+	defer unlock()
+
+	data, err := m.Load(projectId)
+	if err != nil {
+		return nil, err
+	}
+	before := data.clone()
+	if err := fn(data); err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(before, data) {
+		return before, nil
+	}
+	data.Revision = before.Revision + 1
+	if err := m.Save(projectId, data); err != nil {
+		return nil, err
+	}
+	m.committed(projectId, before, data)
+	return data, nil
+}
+
+// View runs fn on the current metadata of the project while no transaction can change it.
+func (m *MemoryStore) View(projectId string, fn func(data *MetadataStoreV1) error) error {
+	unlock := m.lockProject(projectId)
+	defer unlock()
+
+	data, err := m.Load(projectId)
+	if err != nil {
+		return err
+	}
+	return fn(data)
+}
+
+// OnCommit registers the function notified of the committed changes.
+func (m *MemoryStore) OnCommit(fn CommitFunc) {
+	m.onCommit = fn
+}
+
+func (m *MemoryStore) committed(projectId string, before, after *MetadataStoreV1) {
+	if m.onCommit != nil {
+		m.onCommit(projectId, before, after)
+	}
+}
+
+// projectLock serializes the transactions of a project, refs counts the transactions holding
+// or waiting for it.
+type projectLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockProject locks the project for a transaction, returning the function unlocking it. The
+// lock is dropped once no transaction holds or waits for it, so that the memory is bounded by
+// the projects being written rather than by all the projects ever written.
+func (m *MemoryStore) lockProject(projectId string) func() {
+	m.txMu.Lock()
+	l, ok := m.txLocks[projectId]
+	if !ok {
+		l = &projectLock{}
+		m.txLocks[projectId] = l
+	}
+	l.refs++
+	m.txMu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.txMu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(m.txLocks, projectId)
+		}
+		m.txMu.Unlock()
+	}
+}
+
+func (m *MemoryStore) DeleteProject(projectId string) error {
+	_, err := m.RemoveProject(projectId)
+	return err
+}
+
+// RemoveProject deletes the project, returning the metadata it held when deleted, callers must
+// not modify it. A project without metadata returns nil.
+func (m *MemoryStore) RemoveProject(projectId string) (*MetadataStoreV1, error) {
+	// wait for the running transactions so they cannot write the project back
+	unlock := m.lockProject(projectId)
+	defer unlock()
+
+	if err := m.backend.DeleteProject(projectId); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	idx, ok := m.projects[projectId]
+	var deleted *MetadataStoreV1
+	if ok {
+		// the deletion is a change, the project continues from its revision once created again
+		deleted = &MetadataStoreV1{VersionedStore: VersionedStore{Revision: idx.data.Revision + 1}}
+		m.tombstones[projectId] = deleted.Revision
+	}
+	delete(m.projects, projectId)
+	delete(m.corrupted, projectId)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil, nil
+	}
+	m.committed(projectId, idx.data, deleted)
+	return idx.data, nil
+}
+
+func (m *MemoryStore) Projects() ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	projects := make([]string, 0, len(m.projects))
+	for projectId := range m.projects {
+		projects = append(projects, projectId)
+	}
+	sort.Strings(projects)
+	return projects, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return m.backend.Close()
+}
+
+// Contains reports whether the project already stores the key/value pair,
+// referenced by its owner and attributed to its source when they are given.
+func (m *MemoryStore) Contains(projectId string, k *pb.Metadata) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, _, ok := m.lookup(projectId, k)
+	return ok
+}
+
+// Current reports whether asserting the key/value pair again would leave the project unchanged:
+// it is stored (as Contains) and was seen within the SeenResolution.
+func (m *MemoryStore) Current(projectId string, k *pb.Metadata) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key, value, ok := m.lookup(projectId, k)
+	return ok && key.fresh(value, now())
+}
+
+// lookup returns the stored key and value matching the pair, m.mu must be held.
+func (m *MemoryStore) lookup(projectId string, k *pb.Metadata) (*Key, string, bool) {
+	idx, ok := m.projects[projectId]
+	if !ok {
+		return nil, "", false
+	}
+	name, value := Normalize(k.Key), Normalize(k.Value)
+	if _, ok := idx.values[name][value]; !ok {
+		return nil, "", false
+	}
+	key := idx.keys[name]
+	ok = (k.Owner == "" || key.HasOwner(value, k.Owner)) && (k.Source == "" || key.HasSource(value, k.Source))
+	return key, value, ok
+}
+
+// Snapshot returns the current metadata of the project without copying it, callers must not modify it.
+func (m *MemoryStore) Snapshot(projectId string) (*MetadataStoreV1, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.corrupted[projectId]; err != nil {
+		return nil, err
+	}
+	idx, ok := m.projects[projectId]
+	if !ok {
+		return m.empty(projectId), nil
+	}
+	return idx.data, nil
+}
+
+// GetKeyValues returns the stored metadata of a project without copying the whole store.
+// The in-memory copies are never modified in place (Save swaps them) so sharing them is safe.
+func (m *MemoryStore) GetKeyValues(projectId string) ([]*pb.StoredMetadata, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.corrupted[projectId]; err != nil {
+		return nil, err
+	}
+	idx, ok := m.projects[projectId]
+	if !ok {
+		return nil, nil
+	}
+	return idx.data.GetKeyValues()
+}
@@ -0,0 +1,275 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_Projects(t *testing.T) {
+	folder := t.TempDir()
+	for _, name := range []string{"metadata-p1.json", "metadata-p2.json", "metadata.json", "other.json"} {
+		require.NoError(t, os.WriteFile(path.Join(folder, name), []byte(jsonmetadataV1), 0644))
+	}
+	require.NoError(t, os.Mkdir(path.Join(folder, "metadata-dir.json"), 0755))
+
+	projects, err := NewFileStore(folder).Projects()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"p1", "p2"}, projects)
+
+	projects, err = NewFileStore(path.Join(folder, "missing")).Projects()
+	assert.NoError(t, err)
+	assert.Empty(t, projects)
+}
+
+func TestNewMemoryStore(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, os.WriteFile(getFilename(folder, projectId), []byte(jsonmetadataV1), 0644))
+
+	m, err := NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+
+	// data is served from memory once loaded
+	require.NoError(t, os.Remove(getFilename(folder, projectId)))
+
+	got, err := m.Load(projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, got)
+
+	kv, err := m.GetKeyValues(projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.StoredMetadata{{Key: "foo", Values: []string{"bar", "rab"}}}, kv)
+
+	projects, err := m.Projects()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{projectId}, projects)
+}
+
+func TestMemoryStore_Load(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	got, err := m.Load("missing")
+	assert.NoError(t, err)
+	assert.Equal(t, &MetadataStoreV1{}, got)
+
+	require.NoError(t, m.Save(projectId, expectedMetadataV1))
+
+	// changes to a loaded copy are not visible until saved
+	got, err = m.Load(projectId)
+	require.NoError(t, err)
+	got.Keys[0].Values[0] = "changed"
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+	assert.False(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "changed"}))
+}
+
+func TestMemoryStore_ContainsOwnerAndSource(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+	require.NoError(t, m.Save(projectId, &MetadataStoreV1{Metadata: Metadata{Keys: []Key{
+		{Name: "foo", Values: []string{"bar"}, Owners: map[string][]string{"bar": {"o1", "o3"}}, Sources: map[string][]string{"bar": {"app-orch"}}},
+	}}}))
+
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar", Owner: "o3"}))
+	assert.False(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar", Owner: "o2"}))
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar", Owner: "o1", Source: "app-orch"}))
+	assert.False(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar", Source: "edge-infra"}))
+}
+
+func TestMemoryStore_Save(t *testing.T) {
+	folder := t.TempDir()
+	m, err := NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+
+	require.NoError(t, m.Save(projectId, expectedMetadataV1))
+
+	// writes are flushed to the backend
+	data, err := LoadMetadataV1(folder, projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1, data)
+
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "Foo", Value: "RAB"}))
+	assert.False(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "baz"}))
+	assert.False(t, m.Contains("other", &pb.Metadata{Key: "foo", Value: "bar"}))
+
+	// a failing backend leaves the in-memory copy untouched
+	m.backend = NewFileStore(path.Join(folder, "missing"))
+	err = m.Save(projectId, &MetadataStoreV1{})
+	assert.Error(t, err)
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+}
+
+func TestMemoryStore_DeleteProject(t *testing.T) {
+	folder := t.TempDir()
+	m, err := NewMemoryStore(NewFileStore(folder))
+	require.NoError(t, err)
+	require.NoError(t, m.Save(projectId, expectedMetadataV1))
+
+	assert.NoError(t, m.DeleteProject(projectId))
+	assert.False(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "bar"}))
+	_, err = os.Stat(getFilename(folder, projectId))
+	assert.True(t, os.IsNotExist(err))
+
+	kv, err := m.GetKeyValues(projectId)
+	assert.NoError(t, err)
+	assert.Nil(t, kv)
+
+	// the revision continues from the deletion once the project is created again
+	data, err := m.Load(projectId)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1.Revision+1, data.Revision)
+	stored, err := m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMetadataV1.Revision+2, stored.Revision)
+}
+
+func TestMemoryStore_Update(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := m.Update(projectId, func(data *MetadataStoreV1) error {
+				return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: fmt.Sprintf("v%d", i)})
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := m.Load(projectId)
+	require.NoError(t, err)
+	require.Len(t, got.Keys, 1)
+	assert.Len(t, got.Keys[0].Values, 50)
+	assert.Equal(t, uint64(50), got.Revision)
+
+	// a transaction without changes is not saved
+	unchanged, err := m.Update(projectId, func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "v0"})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(50), unchanged.Revision)
+
+	// a failed transaction is not saved
+	_, err = m.Update(projectId, func(data *MetadataStoreV1) error {
+		data.Keys = nil
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.True(t, m.Contains(projectId, &pb.Metadata{Key: "foo", Value: "v0"}))
+}
+
+func TestMemoryStore_UpdateOtherProject(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		_, _ = m.Update("p1", func(*MetadataStoreV1) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	defer func() {
+		close(release)
+		<-finished
+	}()
+
+	// p1 is held by the transaction above, p2 must not wait for it
+	done := make(chan error)
+	go func() {
+		_, err := m.Update("p2", func(data *MetadataStoreV1) error {
+			return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("transaction on p2 blocked by p1")
+	}
+}
+
+func TestMemoryStore_projectLocks(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			projectId := fmt.Sprintf("p%d", i%5)
+			_, err := m.Update(projectId, func(data *MetadataStoreV1) error {
+				return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: fmt.Sprintf("v%d", i)})
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	// the transactions of a project are serialized, none is lost
+	for i := 0; i < 5; i++ {
+		data, err := m.Load(fmt.Sprintf("p%d", i))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), data.Revision)
+	}
+	// the locks of the idle projects are dropped
+	assert.Empty(t, m.txLocks)
+}
+
+func TestMemoryStore_OnCommit(t *testing.T) {
+	m, err := NewMemoryStore(NewFileStore(t.TempDir()))
+	require.NoError(t, err)
+
+	type commit struct {
+		before, after []Key
+		revision      uint64
+	}
+	var commits []commit
+	m.OnCommit(func(projectId string, before, after *MetadataStoreV1) {
+		assert.Equal(t, "p1", projectId)
+		commits = append(commits, commit{withoutTimes(before.Keys), withoutTimes(after.Keys), after.Revision})
+	})
+
+	_, err = m.Update("p1", func(data *MetadataStoreV1) error {
+		return data.CreateOrUpdate(&pb.Metadata{Key: "foo", Value: "bar"})
+	})
+	require.NoError(t, err)
+	// failed transactions are not committed
+	_, err = m.Update("p1", func(*MetadataStoreV1) error { return errors.New("failed") })
+	assert.Error(t, err)
+	require.NoError(t, m.DeleteProject("p1"))
+	// nor are deletions of missing projects
+	require.NoError(t, m.DeleteProject("p1"))
+
+	assert.Equal(t, []commit{
+		{nil, []Key{{Name: "foo", Values: []string{"bar"}}}, 1},
+		{[]Key{{Name: "foo", Values: []string{"bar"}}}, nil, 2},
+	}, commits)
+}
@@ -0,0 +1,203 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package policy evaluates the Rego authorization policy in process, as an alternative to
+// querying the Open Policy Agent sidecar.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/atomix/dazl"
+	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+)
+
+var log = dazl.GetPackageLogger()
+
+// DefaultReloadInterval is how often the policy file is checked for a change.
+const DefaultReloadInterval = 10 * time.Second
+
+// Engine evaluates the rules of a Rego policy file, reloading it when it changes. It answers
+// like the OPA sidecar REST API, so it can replace its client.
+type Engine struct {
+	path string
+
+	mu       sync.RWMutex
+	compiler *ast.Compiler
+	modTime  time.Time
+	// queries are prepared on first use, by rule path
+	queries map[string]*rego.PreparedEvalQuery
+	// onReload are called after the policy is reloaded
+	onReload []func()
+}
+
+var _ openpolicyagent.ClientWithResponsesInterface = &Engine{}
+
+// NewEngine loads and compiles the policy file.
+func NewEngine(path string) (*Engine, error) {
+	e := &Engine{path: path}
+	if _, err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Reload compiles the policy again if the file changed, reporting whether it was reloaded.
+// On error the previous policy is kept.
+func (e *Engine) Reload() (bool, error) {
+	info, err := os.Stat(e.path)
+	if err != nil {
+		return false, err
+	}
+	e.mu.RLock()
+	unchanged := info.ModTime().Equal(e.modTime)
+	e.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	src, err := os.ReadFile(e.path)
+	if err != nil {
+		return false, err
+	}
+	compiler, err := ast.CompileModules(map[string]string{e.path: string(src)})
+	if err != nil {
+		return false, fmt.Errorf("unable to compile the policy %s: %w", e.path, err)
+	}
+
+	e.mu.Lock()
+	e.compiler, e.modTime = compiler, info.ModTime()
+	e.queries = make(map[string]*rego.PreparedEvalQuery)
+	onReload := e.onReload
+	e.mu.Unlock()
+	for _, fn := range onReload {
+		fn()
+	}
+	return true, nil
+}
+
+// OnReload registers fn to be called after the policy is reloaded, e.g. to drop the decisions
+// taken with the previous one.
+func (e *Engine) OnReload(fn func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onReload = append(e.onReload, fn)
+}
+
+// Watch reloads the policy every interval until done is closed.
+func (e *Engine) Watch(interval time.Duration, done <-chan bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			reloaded, err := e.Reload()
+			if err != nil {
+				log.Warnf("Unable to reload the policy, keeping the previous one: %v", err)
+			} else if reloaded {
+				log.Infof("Reloaded the policy %s", e.path)
+			}
+		}
+	}
+}
+
+// query returns the prepared query of the rule, e.g. data.metadatav1.GetRequest.
+func (e *Engine) query(ctx context.Context, path string) (*rego.PreparedEvalQuery, error) {
+	e.mu.RLock()
+	q, compiler := e.queries[path], e.compiler
+	e.mu.RUnlock()
+	if q != nil {
+		return q, nil
+	}
+
+	prepared, err := rego.New(rego.Query(path), rego.Compiler(compiler)).PrepareForEval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// a reload in the meantime replaced the compiler, the query is not cached for it
+	if e.compiler == compiler {
+		e.queries[path] = &prepared
+	}
+	return &prepared, nil
+}
+
+// Eval evaluates the rule of the package against the input, returning its value or nil when
+// it is undefined.
+func (e *Engine) Eval(ctx context.Context, pkg, rule string, input interface{}) (interface{}, error) {
+	path := fmt.Sprintf("data.%s.%s", strings.ReplaceAll(pkg, "/", "."), rule)
+	q, err := e.query(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := q.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, err
+	}
+	if len(rs) == 0 || len(rs[0].Expressions) == 0 {
+		return nil, nil
+	}
+	return rs[0].Expressions[0].Value, nil
+}
+
+// PostV1DataPackageRuleWithBodyWithResponse evaluates the rule against the input in the body,
+// answering as POST /v1/data/{package}/{rule} of the OPA sidecar.
+func (e *Engine) PostV1DataPackageRuleWithBodyWithResponse(ctx context.Context, pPackage string, rule string,
+	_ *openpolicyagent.PostV1DataPackageRuleParams, _ string, body io.Reader, _ ...openpolicyagent.RequestEditorFn) (*openpolicyagent.PostV1DataPackageRuleResponse, error) {
+	var input struct {
+		Input interface{} `json:"input"`
+	}
+	if err := json.NewDecoder(body).Decode(&input); err != nil {
+		return nil, fmt.Errorf("invalid OPA input: %w", err)
+	}
+	value, err := e.Eval(ctx, pPackage, rule, input.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	// the result is omitted when the rule is undefined, as the sidecar does
+	answer := map[string]interface{}{}
+	if value != nil {
+		answer["result"] = value
+	}
+	raw, err := json.Marshal(answer)
+	if err != nil {
+		return nil, err
+	}
+	var resp openpolicyagent.OpaResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	return &openpolicyagent.PostV1DataPackageRuleResponse{
+		Body:         raw,
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK, Status: http.StatusText(http.StatusOK)},
+		JSON200:      &resp,
+	}, nil
+}
+
+// PostV1DataPackageRuleWithResponse evaluates the rule against the input.
+func (e *Engine) PostV1DataPackageRuleWithResponse(ctx context.Context, pPackage string, rule string,
+	params *openpolicyagent.PostV1DataPackageRuleParams, body openpolicyagent.PostV1DataPackageRuleJSONRequestBody,
+	reqEditors ...openpolicyagent.RequestEditorFn) (*openpolicyagent.PostV1DataPackageRuleResponse, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return e.PostV1DataPackageRuleWithBodyWithResponse(ctx, pPackage, rule, params, "application/json", bytes.NewReader(raw), reqEditors...)
+}
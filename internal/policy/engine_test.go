@@ -0,0 +1,117 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyDir = "../../deployments/orch-metadata-broker/files/openpolicyagent"
+
+func readInput(t *testing.T, name string) openpolicyagent.OpaInput {
+	raw, err := os.ReadFile(filepath.Join(policyDir, "testdata", name))
+	require.NoError(t, err)
+	var input openpolicyagent.OpaInput
+	require.NoError(t, json.Unmarshal(raw, &input.Input))
+	return input
+}
+
+// allowed returns the decision the way authCheckAllowed reads the answer of the sidecar.
+func allowed(t *testing.T, e *Engine, rule string, input openpolicyagent.OpaInput) bool {
+	resp, err := e.PostV1DataPackageRuleWithResponse(context.Background(), "metadatav1", rule, nil, input)
+	require.NoError(t, err)
+	result, err := resp.JSON200.Result.AsOpaResponseResult1()
+	return err == nil && result
+}
+
+// TestEngine mirrors the OPA eval tests of the testdata Makefile.
+func TestEngine(t *testing.T) {
+	e, err := NewEngine(filepath.Join(policyDir, "policies.rego"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		input   string
+		rule    string
+		allowed bool
+	}{
+		{"t1", "writeRoleWithProject.json", "GetRequest", true},
+		{"t2", "readRoleWithProject.json", "GetRequest", true},
+		{"t3d", "readRoleWithProject.json", "CreateOrUpdateRequest", false},
+		{"t3a", "writeRoleWithProject.json", "CreateOrUpdateRequest", true},
+		{"t4d", "readRoleWithProject.json", "DeleteRequest", false},
+		{"t4a", "writeRoleWithProject.json", "DeleteRequest", true},
+		{"t5d", "readRoleWithProject.json", "DeleteProjectRequest", false},
+		{"t5a", "writeRoleWithProject.json", "DeleteProjectRequest", true},
+		{"t6d", "readRoleWithProject.json", "AdminRequest", false},
+		{"t6a", "writeRoleWithProject.json", "AdminRequest", true},
+		{"t7a", "writeRequestWithProject.json", "CreateOrUpdateRequest", true},
+		{"t7d", "writeRequestWithProject.json", "AdminRequest", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.allowed, allowed(t, e, tc.rule, readInput(t, tc.input)))
+		})
+	}
+}
+
+func TestEngine_undefined(t *testing.T) {
+	e, err := NewEngine(filepath.Join(policyDir, "policies.rego"))
+	require.NoError(t, err)
+
+	resp, err := e.PostV1DataPackageRuleWithResponse(context.Background(), "metadatav1", "AdminRequest", nil,
+		readInput(t, "readRoleWithProject.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(resp.Body))
+	_, err = resp.JSON200.Result.AsOpaResponseResult1()
+	assert.Error(t, err)
+}
+
+func TestEngine_reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policies.rego")
+	write := func(policy string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(policy), 0600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	input := openpolicyagent.OpaInput{Input: map[string]interface{}{"rpc": "GetMetadata"}}
+
+	write("package metadatav1\n\nGetRequest := false\n", time.Now().Add(-time.Minute))
+	_, err := NewEngine(filepath.Join(t.TempDir(), "missing.rego"))
+	assert.Error(t, err)
+	e, err := NewEngine(path)
+	require.NoError(t, err)
+	reloads := 0
+	e.OnReload(func() { reloads++ })
+	assert.False(t, allowed(t, e, "GetRequest", input))
+
+	reloaded, err := e.Reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+
+	write("package metadatav1\n\nGetRequest if input.rpc == \"GetMetadata\"\n", time.Now())
+	reloaded, err = e.Reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, 1, reloads)
+	assert.True(t, allowed(t, e, "GetRequest", input))
+
+	// a broken policy keeps the previous one
+	write("package metadatav1\n\nGetRequest if {", time.Now().Add(time.Minute))
+	reloaded, err = e.Reload()
+	assert.Error(t, err)
+	assert.False(t, reloaded)
+	assert.Equal(t, 1, reloads)
+	assert.True(t, allowed(t, e, "GetRequest", input))
+}
@@ -0,0 +1,162 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EventsPath is the path, below the base path, of the Server-Sent Events feed of the metadata changes.
+const EventsPath = "metadata.orchestrator.apis/v1/metadata/events"
+
+// keepAliveInterval is how often a comment is sent on idle feeds, so that proxies don't close them.
+const keepAliveInterval = 30 * time.Second
+
+// eventsHandler streams the changes of the active project as Server-Sent Events.
+// Every event carries a WatchMetadataResponse as JSON and its revision as id:
+// a new feed starts with the snapshot of the metadata, a feed reconnecting with
+// Last-Event-ID resumes after that revision (or starts over with a snapshot when
+// the revision can no longer be resumed).
+// The authorization is checked by the WatchMetadata RPC, as for the other REST calls.
+type eventsHandler struct {
+	client pb.MetadataServiceClient
+	// mux formats the errors like the other REST calls
+	mux       *runtime.ServeMux
+	marshaler protojson.MarshalOptions
+}
+
+func newEventsHandler(client pb.MetadataServiceClient, mux *runtime.ServeMux) *eventsHandler {
+	return &eventsHandler{
+		client:    client,
+		mux:       mux,
+		marshaler: protojson.MarshalOptions{EmitUnpopulated: true},
+	}
+}
+
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	req := &pb.WatchMetadataRequest{Snapshot: true}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		revision, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			h.error(w, r, status.Errorf(codes.InvalidArgument, "invalid Last-Event-ID %q", lastEventID))
+			return
+		}
+		req = &pb.WatchMetadataRequest{ResumeRevision: revision}
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		"authorization", r.Header.Get("Authorization"),
+		"auth", r.Header.Get("Authorization"),
+		"client", r.Header.Get("User-Agent"),
+		ActiveProjectID, r.Header.Get(ActiveProjectID),
+	))
+
+	stream, err := h.watch(ctx, req)
+	if status.Code(err) == codes.OutOfRange {
+		log.Debugf("Unable to resume the metadata events: %v", err)
+		stream, err = h.watch(ctx, &pb.WatchMetadataRequest{Snapshot: true})
+	}
+	if err != nil {
+		h.error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	responses := make(chan *pb.WatchMetadataResponse)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case responses <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errs:
+			// the client reconnects with the last id it received
+			log.Debugf("Metadata events ended: %v", err)
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case resp := <-responses:
+			data, err := h.marshaler.Marshal(resp)
+			if err != nil {
+				log.Errorf("Unable to marshal the metadata event: %v", err)
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: metadata\ndata: %s\n\n", resp.Revision, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// watch opens the WatchMetadata stream and waits until the server has accepted it,
+// so that the errors are reported before the event stream starts.
+func (h *eventsHandler) watch(ctx context.Context, req *pb.WatchMetadataRequest) (pb.MetadataService_WatchMetadataClient, error) {
+	stream, err := h.client.WatchMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	md, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+	if md == nil {
+		// the stream ended without headers, its status tells why
+		if _, err := stream.Recv(); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "watch ended unexpectedly")
+	}
+	return stream, nil
+}
+
+func (h *eventsHandler) error(w http.ResponseWriter, r *http.Request, err error) {
+	runtime.HTTPError(r.Context(), h.mux, &runtime.JSONPb{}, w, r, err)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	metadatagrpc "github.com/open-edge-platform/orch-metadata-broker/internal/grpc"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const eventsProject = "eventsProject"
+
+// newEventsTestServer serves the events of a gRPC server running on a bufconn listener.
+func newEventsTestServer(t *testing.T, opaClient openpolicyagent.ClientWithResponsesInterface) *httptest.Server {
+	t.Helper()
+	require.NoError(t, impl.Init("", t.TempDir()))
+
+	lis := bufconn.Listen(1024 * 1024)
+	// nosemgrep: go.grpc.security.grpc-server-insecure-connection.grpc-server-insecure-connection
+	server := grpc.NewServer()
+	metadatagrpc.NewService(opaClient, nil, nil).Register(server)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	srv := httptest.NewServer(newEventsHandler(pb.NewMetadataServiceClient(conn), runtime.NewServeMux()))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+type sseEvent struct {
+	id, event, data string
+}
+
+func openEvents(t *testing.T, url, lastEventID string) (*http.Response, *bufio.Reader) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set(ActiveProjectID, eventsProject)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp, bufio.NewReader(resp.Body)
+}
+
+func readEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+	var e sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return e
+		case strings.HasPrefix(line, "id: "):
+			e.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			e.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			e.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func assertResponse(t *testing.T, want *pb.WatchMetadataResponse, e sseEvent) {
+	t.Helper()
+	got := &pb.WatchMetadataResponse{}
+	require.NoError(t, protojson.Unmarshal([]byte(e.data), got))
+	assert.True(t, proto.Equal(want, got), "expected %v, got %v", want, got)
+}
+
+func create(t *testing.T, key, value string) {
+	t.Helper()
+	projectId := eventsProject
+	_, err := impl.CreateOrUpdateList(&projectId, []*pb.Metadata{{Key: key, Value: value}}, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestEvents(t *testing.T) {
+	srv := newEventsTestServer(t, nil)
+	create(t, "color", "red")
+
+	resp, events := openEvents(t, srv.URL, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	e := readEvent(t, events)
+	assert.Equal(t, "1", e.id)
+	assert.Equal(t, "metadata", e.event)
+	assertResponse(t, &pb.WatchMetadataResponse{
+		Revision: 1,
+		Snapshot: []*pb.StoredMetadata{{Key: "color", Values: []string{"red"}}},
+	}, e)
+
+	create(t, "color", "blue")
+	e = readEvent(t, events)
+	assert.Equal(t, "2", e.id)
+	assertResponse(t, &pb.WatchMetadataResponse{
+		Revision: 2,
+		Events: []*pb.MetadataEvent{{
+			Type:     pb.MetadataEvent_EVENT_TYPE_CREATED,
+			Metadata: &pb.Metadata{Key: "color", Value: "blue"},
+		}},
+	}, e)
+
+	// a reconnecting client gets what it missed
+	_, events = openEvents(t, srv.URL, "1")
+	assert.Equal(t, "2", readEvent(t, events).id)
+
+	// and starts over when its revision can't be resumed
+	_, events = openEvents(t, srv.URL, "100")
+	assertResponse(t, &pb.WatchMetadataResponse{
+		Revision: 2,
+		Snapshot: []*pb.StoredMetadata{{Key: "color", Values: []string{"red", "blue"}}},
+	}, readEvent(t, events))
+
+	resp, _ = openEvents(t, srv.URL, "not-a-revision")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEvents_Denied(t *testing.T) {
+	opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(t))
+	result := openpolicyagent.OpaResponse_Result{}
+	require.NoError(t, result.FromOpaResponseResult1(false))
+	opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(gomock.Any(), "metadatav1", "GetRequest", gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&openpolicyagent.PostV1DataPackageRuleResponse{
+			JSON200:      &openpolicyagent.OpaResponse{Result: result},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil,
+	)
+	srv := newEventsTestServer(t, opaMock)
+
+	resp, _ := openEvents(t, srv.URL, "")
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+	assert.NotEqual(t, "text/event-stream", resp.Header.Get("Content-Type"))
+}
+
+// TestNewServer_EventsRoute verifies that the events feed is dispatched next to the gateway routes.
+func TestNewServer_EventsRoute(t *testing.T) {
+	srv := buildServer(t, "http://127.0.0.1:19999")
+	req := httptest.NewRequest(http.MethodGet, "/"+EventsPath, nil)
+	req.Header.Set(ActiveProjectID, eventsProject)
+	rr := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rr, req)
+	// no gRPC server is listening
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
@@ -0,0 +1,27 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsPath is the path of the Prometheus metrics on the metrics port.
+const MetricsPath = "/metrics"
+
+// NewMetricsServer serves the Prometheus metrics on metricsPort. They aren't authenticated,
+// so the port must only be reachable from within the cluster, never through the public REST port.
+func NewMetricsServer(metricsPort int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET "+MetricsPath, promhttp.Handler())
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", metricsPort),
+		Handler: mux,
+	}
+}
@@ -23,6 +23,7 @@ import (
 	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
@@ -34,6 +35,7 @@ var log = dazl.GetPackageLogger()
 
 var allowedHeaders = map[string]struct{}{
 	"x-request-id": {},
+	"etag":         {},
 }
 
 func isHeaderAllowed(s string) (string, bool) {
@@ -53,12 +55,27 @@ const (
 	defaultTenantManagerURL   = "http://tenancy-manager.orch-iam:8080"
 )
 
-func NewServer(restPort int, grpcPort int, basePath string, allowedCorsOrigins string, openapiSpecFile string) *http.Server {
-	return newServerWithTenantURL(restPort, grpcPort, basePath, allowedCorsOrigins, openapiSpecFile, os.Getenv(tenantManagerURLEnvVar))
+// forwardedMetadata returns the headers of the client request sent to the gRPC server as metadata.
+func forwardedMetadata(_ context.Context, request *http.Request) metadata.MD {
+	authHeader := request.Header.Get("Authorization")
+	uaHeader := request.Header.Get("User-Agent")
+	projectIDHeader := request.Header.Get(ActiveProjectID)
+	ifMatchHeader := request.Header.Get("If-Match")
+	requestIDHeader := request.Header.Get("X-Request-Id")
+	// send all the headers received from the client
+	md := metadata.Pairs("auth", authHeader, "client", uaHeader, ActiveProjectID, projectIDHeader, "if-match", ifMatchHeader,
+		"x-request-id", requestIDHeader)
+	return md
+}
+
+// NewServer creates the REST gateway of the gRPC server listening on grpcPort, dialed with creds
+// (in plaintext when nil).
+func NewServer(restPort int, grpcPort int, basePath string, allowedCorsOrigins string, openapiSpecFile string, creds credentials.TransportCredentials) *http.Server {
+	return newServerWithTenantURL(restPort, grpcPort, basePath, allowedCorsOrigins, openapiSpecFile, os.Getenv(tenantManagerURLEnvVar), creds)
 }
 
 // newServerWithTenantURL is the internal constructor — testable via dependency injection.
-func newServerWithTenantURL(restPort int, grpcPort int, basePath string, allowedCorsOrigins string, openapiSpecFile string, tenantManagerURL string) *http.Server {
+func newServerWithTenantURL(restPort int, grpcPort int, basePath string, allowedCorsOrigins string, openapiSpecFile string, tenantManagerURL string, creds credentials.TransportCredentials) *http.Server {
 	if tenantManagerURL == "" {
 		tenantManagerURL = defaultTenantManagerURL
 	}
@@ -68,24 +85,29 @@ func newServerWithTenantURL(restPort int, grpcPort int, basePath string, allowed
 	mux := runtime.NewServeMux(
 		// convert header in response(going from gateway) from metadata received.
 		runtime.WithOutgoingHeaderMatcher(isHeaderAllowed),
-		runtime.WithMetadata(func(ctx context.Context, request *http.Request) metadata.MD {
-			authHeader := request.Header.Get("Authorization")
-			uaHeader := request.Header.Get("User-Agent")
-			projectIDHeader := request.Header.Get(ActiveProjectID)
-			// send all the headers received from the client
-			md := metadata.Pairs("auth", authHeader, "client", uaHeader, ActiveProjectID, projectIDHeader)
-			return md
-		}),
+		runtime.WithMetadata(forwardedMetadata),
 		runtime.WithRoutingErrorHandler(ginmiddleware.HandleRoutingError),
 	)
 
 	// setting up a dail up for gRPC service by specifying endpoint/target url
-	err := pb.RegisterMetadataServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("localhost:%d", grpcPort),
-		[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
+	grpcEndpoint := fmt.Sprintf("localhost:%d", grpcPort)
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	err := pb.RegisterMetadataServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, dialOpts)
 	if err != nil {
 		log.Fatalw("Failed to register MetadataService handler", dazl.Error(err))
 	}
 
+	// the event feed streams from the gRPC server directly, the gateway can't
+	conn, err := grpc.NewClient(grpcEndpoint, dialOpts...)
+	if err != nil {
+		log.Fatalw("Failed to create the MetadataService client", dazl.Error(err))
+	}
+	events := newEventsHandler(pb.NewMetadataServiceClient(conn), mux)
+	eventsPath := basePath + EventsPath
+
 	router := gin.New()
 	// check if another method is allowed for the current route, if the current request can not be routed.
 	// If this is the case, the request is answered with 'Method Not Allowed' and HTTP status code 405
@@ -120,11 +142,21 @@ func newServerWithTenantURL(restPort int, grpcPort int, basePath string, allowed
 	if len(corsOrigins) > 1 {
 		config := cors.DefaultConfig()
 		config.AllowOrigins = corsOrigins
+		config.AddAllowHeaders("If-Match")
+		config.AddExposeHeaders("ETag")
 		router.Use(cors.New(config))
 	}
 
+	// the events path can't have its own route next to the catch-all one, dispatch it here
+	apis := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == eventsPath {
+			events.ServeHTTP(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
 	router.Group(fmt.Sprintf("%smetadata.orchestrator.apis/v1/*{grpc_gateway}", basePath)).Match(allowedMethods, "", gin.WrapH(
-		projectcontext.InjectActiveProjectID(tenantManagerURL, false)(mux),
+		projectcontext.InjectActiveProjectID(tenantManagerURL, false)(apis),
 	))
 	router.GET("/test", func(c *gin.Context) {
 		c.String(http.StatusOK, "Ok")
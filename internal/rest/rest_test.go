@@ -5,15 +5,21 @@ package rest
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
+	"github.com/open-edge-platform/orch-metadata-broker/internal/audit"
+	metadatagrpc "github.com/open-edge-platform/orch-metadata-broker/internal/grpc"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/impl"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 // specPath returns the path to the openapi.yaml bundled with this repo.
@@ -36,7 +42,7 @@ func specPath(t *testing.T) string {
 func buildServer(t *testing.T, tenantManagerURL string) *http.Server {
 	t.Helper()
 	// Use ports that nothing is listening on — gRPC gateway connection is lazy.
-	return newServerWithTenantURL(19801, 19802, "/", "", specPath(t), tenantManagerURL)
+	return newServerWithTenantURL(19801, 19802, "/", "", specPath(t), tenantManagerURL, nil)
 }
 
 // TestNewServer_HealthzEndpoint verifies the /healthz route is registered
@@ -58,11 +64,38 @@ func TestNewServer_TestEndpoint(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
+// TestNewServer_NoMetricsEndpoint verifies the Prometheus metrics aren't served on the public REST port.
+func TestNewServer_NoMetricsEndpoint(t *testing.T) {
+	srv := buildServer(t, "http://127.0.0.1:19999")
+	req := httptest.NewRequest(http.MethodGet, MetricsPath, nil)
+	rr := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "go_goroutines")
+}
+
+// TestNewMetricsServer verifies the metrics server serves the Prometheus metrics on its own port.
+func TestNewMetricsServer(t *testing.T) {
+	srv := NewMetricsServer(19805)
+	assert.Equal(t, fmt.Sprintf(":%d", 19805), srv.Addr)
+
+	req := httptest.NewRequest(http.MethodGet, MetricsPath, nil)
+	rr := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "go_goroutines")
+
+	req = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rr = httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
 // TestNewServer_EmptyTenantManagerURL_SmokeTest verifies that an empty tenantManagerURL
 // is replaced with the default value (smoke-test the fallback branch).
 func TestNewServer_EmptyTenantManagerURL_SmokeTest(t *testing.T) {
 	// Passing empty string should not panic and still produce a usable server.
-	srv := newServerWithTenantURL(19803, 19804, "/", "", specPath(t), "")
+	srv := newServerWithTenantURL(19803, 19804, "/", "", specPath(t), "", nil)
 	require.NotNil(t, srv)
 }
 
@@ -78,6 +111,38 @@ func TestNewServer_WithTenantManagerURLInEnv(t *testing.T) {
 	t.Setenv("TENANT_MANAGER_URL", "http://custom-tenant-manager:9090")
 	// NewServer calls newServerWithTenantURL internally — just verify it doesn't panic
 	// or fatal when the env var is set correctly.
-	srv := NewServer(19805, 19806, "/", "", specPath(t))
+	srv := NewServer(19805, 19806, "/", "", specPath(t), nil)
 	require.NotNil(t, srv)
 }
+
+// TestNewServer_ForwardsRequestID verifies that the X-Request-Id header reaches the gRPC
+// server, which records it in the audit events.
+func TestNewServer_ForwardsRequestID(t *testing.T) {
+	require.NoError(t, impl.Init("", t.TempDir()))
+	auditLog, err := audit.Open(filepath.Join(t.TempDir(), "audit.log"), audit.DefaultMaxSize, audit.DefaultMaxFiles)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = auditLog.Close() })
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	// nosemgrep: go.grpc.security.grpc-server-insecure-connection.grpc-server-insecure-connection
+	server := grpc.NewServer()
+	metadatagrpc.NewService(nil, nil, auditLog).Register(server)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	srv := newServerWithTenantURL(19807, lis.Addr().(*net.TCPAddr).Port, "/", "", specPath(t), "http://127.0.0.1:19999", nil)
+	req := httptest.NewRequest(http.MethodPost, "/metadata.orchestrator.apis/v1/metadata",
+		strings.NewReader(`{"metadata": [{"key": "customer", "value": "culvers"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ActiveProjectID, "p1")
+	req.Header.Set("X-Request-Id", "req-123")
+	rr := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	events, _, err := auditLog.List(audit.Filter{ProjectID: "p1"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "req-123", events[0].RequestID)
+}
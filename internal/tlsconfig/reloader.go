@@ -0,0 +1,198 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package tlsconfig provides the TLS configurations of the gRPC server and of its in-pod
+// clients, reloading the certificates from disk when they are rotated.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/atomix/dazl"
+)
+
+var log = dazl.GetPackageLogger()
+
+// DefaultReloadInterval is how often the files are checked for a rotation.
+const DefaultReloadInterval = 30 * time.Second
+
+// Files locates the PEM files of the TLS configuration.
+type Files struct {
+	// CAPath is the CA bundle verifying the peers, the system roots are used without it
+	CAPath string
+	// CertPath and KeyPath are the certificate and private key presented to the peers
+	CertPath string
+	KeyPath  string
+	// RequireClientCert enables mutual TLS: clients must present a certificate issued by the CA
+	RequireClientCert bool
+}
+
+// Enabled reports whether TLS is configured, it requires a certificate and its key.
+func (f Files) Enabled() bool {
+	return f.CertPath != "" && f.KeyPath != ""
+}
+
+// Reloader holds the certificates loaded from the files, reloading them when they change.
+// The configurations it returns always use the latest certificates.
+type Reloader struct {
+	files Files
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes []time.Time
+}
+
+// NewReloader loads the certificates of the files.
+func NewReloader(files Files) (*Reloader, error) {
+	if !files.Enabled() {
+		return nil, errors.New("TLS requires a certificate and its private key")
+	}
+	if files.RequireClientCert && files.CAPath == "" {
+		return nil, errors.New("mutual TLS requires a CA to verify the client certificates")
+	}
+	r := &Reloader{files: files}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificates again if any of the files changed, reporting whether they
+// were reloaded. On error the previous certificates are kept.
+func (r *Reloader) Reload() (bool, error) {
+	modTimes, err := r.stat()
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	unchanged := slices.EqualFunc(modTimes, r.modTimes, time.Time.Equal)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.files.CertPath, r.files.KeyPath)
+	if err != nil {
+		return false, fmt.Errorf("unable to load the certificate %s: %w", r.files.CertPath, err)
+	}
+	var pool *x509.CertPool
+	if r.files.CAPath != "" {
+		pem, err := os.ReadFile(r.files.CAPath)
+		if err != nil {
+			return false, err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("no certificate found in the CA %s", r.files.CAPath)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.pool, r.modTimes = &cert, pool, modTimes
+	return true, nil
+}
+
+// stat returns the modification times of the files.
+func (r *Reloader) stat() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, path := range []string{r.files.CAPath, r.files.CertPath, r.files.KeyPath} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+// Watch reloads the certificates every interval until done is closed.
+func (r *Reloader) Watch(interval time.Duration, done <-chan bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				log.Warnf("Unable to reload the TLS certificates, keeping the previous ones: %v", err)
+			} else if reloaded {
+				log.Infof("Reloaded the TLS certificates %s", r.files.CertPath)
+			}
+		}
+	}
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.pool
+}
+
+// ServerConfig returns the configuration of the gRPC listener. With mutual TLS clients must
+// present a certificate issued by the CA, otherwise the certificates they present are verified.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   tls.NoClientCert,
+			}
+			if r.files.RequireClientCert {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			} else if pool != nil {
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns the configuration dialing the gRPC listener: the server is verified
+// with the CA and the certificate is presented for mutual TLS.
+func (r *Reloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		},
+		// the CA may be rotated, the server is verified against the current one below
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, pool := r.current()
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("the server presented no certificate")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range cs.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+				Roots:         pool,
+				Intermediates: intermediates,
+				DNSName:       cs.ServerName,
+			})
+			return err
+		},
+	}
+}
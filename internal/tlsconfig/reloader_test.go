@@ -0,0 +1,172 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCerts writes a CA and a localhost certificate issued by it, with the given serial.
+func writeCerts(t *testing.T, dir string, serial int64, modTime time.Time) Files {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err = x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	files := Files{
+		CAPath:   filepath.Join(dir, "ca.crt"),
+		CertPath: filepath.Join(dir, "tls.crt"),
+		KeyPath:  filepath.Join(dir, "tls.key"),
+	}
+	for path, block := range map[string]*pem.Block{
+		files.CAPath:   {Type: "CERTIFICATE", Bytes: caDER},
+		files.CertPath: {Type: "CERTIFICATE", Bytes: leafDER},
+		files.KeyPath:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	return files
+}
+
+// serve accepts TLS connections completing their handshake until the test ends.
+func serve(t *testing.T, cfg *tls.Config) string {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+// handshake returns the serial number of the server certificate.
+func handshake(addr string, cfg *tls.Config) (int64, error) {
+	cfg = cfg.Clone()
+	cfg.ServerName = "localhost"
+	conn, err := tls.Dial("tcp", addr, cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close() }()
+	// the server rejects a missing client certificate after the client handshake completes
+	if _, err := conn.Read(make([]byte, 1)); err != nil && err != io.EOF {
+		return 0, err
+	}
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestNewReloader(t *testing.T) {
+	_, err := NewReloader(Files{CAPath: "ca.crt"})
+	assert.Error(t, err)
+	_, err = NewReloader(Files{CertPath: "tls.crt", KeyPath: "tls.key", RequireClientCert: true})
+	assert.Error(t, err)
+	_, err = NewReloader(Files{CertPath: "missing.crt", KeyPath: "missing.key"})
+	assert.Error(t, err)
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	files := writeCerts(t, dir, 1, time.Now().Add(-time.Minute))
+	files.RequireClientCert = true
+	r, err := NewReloader(files)
+	require.NoError(t, err)
+	addr := serve(t, r.ServerConfig())
+
+	serial, err := handshake(addr, r.ClientConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), serial)
+
+	// without a client certificate
+	pool := x509.NewCertPool()
+	caPEM, err := os.ReadFile(files.CAPath)
+	require.NoError(t, err)
+	pool.AppendCertsFromPEM(caPEM)
+	_, err = handshake(addr, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+	assert.Error(t, err)
+
+	reloaded, err := r.Reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// rotated certificates are served without a restart
+	writeCerts(t, dir, 2, time.Now())
+	reloaded, err = r.Reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+	serial, err = handshake(addr, r.ClientConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), serial)
+
+	// a broken rotation keeps the previous certificates
+	require.NoError(t, os.WriteFile(files.KeyPath, []byte("broken"), 0600))
+	reloaded, err = r.Reload()
+	assert.Error(t, err)
+	assert.False(t, reloaded)
+	serial, err = handshake(addr, r.ClientConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), serial)
+}
+
+func TestClientConfig_unknownCA(t *testing.T) {
+	server, err := NewReloader(writeCerts(t, t.TempDir(), 1, time.Now()))
+	require.NoError(t, err)
+	client, err := NewReloader(writeCerts(t, t.TempDir(), 2, time.Now()))
+	require.NoError(t, err)
+	addr := serve(t, server.ServerConfig())
+
+	_, err = handshake(addr, client.ClientConfig())
+	assert.Error(t, err)
+}
@@ -0,0 +1,230 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package watch fans out the changes of the project metadata to the clients watching them.
+package watch
+
+import (
+	"sync"
+
+	"github.com/atomix/dazl"
+	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = dazl.GetPackageLogger()
+
+const (
+	// DefaultHistory is the number of changes kept per project to resume watches.
+	DefaultHistory = 1024
+	// subscriptionBuffer is the number of changes queued for a subscriber before it is dropped.
+	subscriptionBuffer = 256
+)
+
+// Hub turns the commits of a models.MemoryStore into per-project changes,
+// numbered by the revision of the project once committed,
+// and delivers them to the subscribers of the project.
+type Hub struct {
+	history  int
+	mu       sync.Mutex
+	projects map[string]*projectLog
+}
+
+// projectLog holds the latest changes of a project and its subscribers.
+// changes holds every published change made after revision since.
+type projectLog struct {
+	revision    uint64
+	since       uint64
+	changes     []*pb.WatchMetadataResponse
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub returns a Hub keeping the last history changes of every project.
+func NewHub(history int) *Hub {
+	return &Hub{
+		history:  history,
+		projects: map[string]*projectLog{},
+	}
+}
+
+func (h *Hub) project(projectId string) *projectLog {
+	p, ok := h.projects[projectId]
+	if !ok {
+		p = &projectLog{subscribers: map[*Subscription]struct{}{}}
+		h.projects[projectId] = p
+	}
+	return p
+}
+
+// Commit is a models.CommitFunc publishing the values created, deleted and renamed by the change.
+// Commits that don't change any value (e.g. only references) advance the revision but are not published.
+func (h *Hub) Commit(projectId string, before, after *models.MetadataStoreV1) {
+	events := diff(before, after)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p := h.project(projectId)
+	if before.Revision != p.revision {
+		// the project has been deleted and created again, its history is gone
+		p.changes = nil
+		p.since = before.Revision
+	}
+	p.revision = after.Revision
+	if len(events) == 0 {
+		return
+	}
+	change := &pb.WatchMetadataResponse{Revision: p.revision, Events: events}
+	p.changes = append(p.changes, change)
+	if len(p.changes) > h.history {
+		p.since = p.changes[len(p.changes)-h.history-1].Revision
+		p.changes = p.changes[len(p.changes)-h.history:]
+	}
+
+	for sub := range p.subscribers {
+		select {
+		case sub.c <- change:
+		default:
+			// never block the writers on a slow watcher, it resumes from its last revision
+			log.Warnf("Dropping watcher of project %s lagging at revision %d", projectId, p.revision)
+			sub.err = status.Errorf(codes.ResourceExhausted,
+				"watch fell behind, resume after the last revision received")
+			delete(p.subscribers, sub)
+			close(sub.c)
+		}
+	}
+}
+
+// Subscribe starts delivering the changes of the project, whose current revision is given.
+// When resume is not zero the changes made after that revision are returned to be sent
+// before the new ones; resuming from a revision that is no longer (or not yet) known fails
+// with OutOfRange.
+func (h *Hub) Subscribe(projectId string, revision uint64, resume uint64) (*Subscription, []*pb.WatchMetadataResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p := h.project(projectId)
+	if p.revision != revision {
+		// no change seen since the hub started (or since the project was recreated)
+		p.revision = revision
+		p.since = revision
+		p.changes = nil
+	}
+	var backlog []*pb.WatchMetadataResponse
+	if resume != 0 {
+		if resume > p.revision {
+			return nil, nil, status.Errorf(codes.OutOfRange, "unknown revision %d, current revision is %d", resume, p.revision)
+		}
+		if resume < p.since {
+			return nil, nil, status.Errorf(codes.OutOfRange, "revision %d is no longer available, resume with a snapshot", resume)
+		}
+		for _, change := range p.changes {
+			if change.Revision > resume {
+				backlog = append(backlog, change)
+			}
+		}
+	}
+
+	sub := &Subscription{
+		hub:       h,
+		projectId: projectId,
+		c:         make(chan *pb.WatchMetadataResponse, subscriptionBuffer),
+	}
+	p.subscribers[sub] = struct{}{}
+	return sub, backlog, nil
+}
+
+// Subscription receives the changes of a project.
+type Subscription struct {
+	hub       *Hub
+	projectId string
+	c         chan *pb.WatchMetadataResponse
+	err       error
+}
+
+// C delivers the changes, it is closed when the subscription ends.
+func (s *Subscription) C() <-chan *pb.WatchMetadataResponse {
+	return s.c
+}
+
+// Err returns why the hub ended the subscription, once C is closed.
+func (s *Subscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.err
+}
+
+// Close stops the subscription.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	p := s.hub.projects[s.projectId]
+	if _, ok := p.subscribers[s]; ok {
+		delete(p.subscribers, s)
+		close(s.c)
+	}
+}
+
+// diff lists the values deleted, renamed and created between two versions of a project.
+// The values renamed by the change are not reported as deleted and created again.
+func diff(before, after *models.MetadataStoreV1) []*pb.MetadataEvent {
+	beforeValues, afterValues := values(before), values(after)
+	renamedFrom, renamedTo := map[string]map[string]struct{}{}, map[string]map[string]struct{}{}
+	for _, r := range after.Renames {
+		addValue(renamedFrom, r.From.Key, r.From.Value)
+		addValue(renamedTo, r.To.Key, r.To.Value)
+	}
+
+	var events []*pb.MetadataEvent
+	for _, k := range before.Keys {
+		for _, v := range k.Values {
+			_, kept := afterValues[k.Name][v]
+			_, renamed := renamedFrom[k.Name][v]
+			if !kept && !renamed {
+				events = append(events, event(pb.MetadataEvent_EVENT_TYPE_DELETED, k.Name, v))
+			}
+		}
+	}
+	for _, r := range after.Renames {
+		e := event(pb.MetadataEvent_EVENT_TYPE_RENAMED, r.To.Key, r.To.Value)
+		e.Previous = &pb.Metadata{Key: r.From.Key, Value: r.From.Value}
+		events = append(events, e)
+	}
+	for _, k := range after.Keys {
+		for _, v := range k.Values {
+			_, existed := beforeValues[k.Name][v]
+			_, renamed := renamedTo[k.Name][v]
+			if !existed && !renamed {
+				events = append(events, event(pb.MetadataEvent_EVENT_TYPE_CREATED, k.Name, v))
+			}
+		}
+	}
+	return events
+}
+
+func values(m *models.MetadataStoreV1) map[string]map[string]struct{} {
+	set := make(map[string]map[string]struct{}, len(m.Keys))
+	for _, k := range m.Keys {
+		set[k.Name] = make(map[string]struct{}, len(k.Values))
+		for _, v := range k.Values {
+			set[k.Name][v] = struct{}{}
+		}
+	}
+	return set
+}
+
+func addValue(set map[string]map[string]struct{}, key, value string) {
+	if set[key] == nil {
+		set[key] = map[string]struct{}{}
+	}
+	set[key][value] = struct{}{}
+}
+
+func event(t pb.MetadataEvent_EventType, key, value string) *pb.MetadataEvent {
+	return &pb.MetadataEvent{Type: t, Metadata: &pb.Metadata{Key: key, Value: value}}
+}
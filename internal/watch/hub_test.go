@@ -0,0 +1,231 @@
+/*
+* SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+* SPDX-License-Identifier: Apache-2.0
+ */
+
+package watch
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/orch-metadata-broker/internal/models"
+	pb "github.com/open-edge-platform/orch-metadata-broker/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const project = "p1"
+
+func store(keys ...models.Key) *models.MetadataStoreV1 {
+	return &models.MetadataStoreV1{Metadata: models.Metadata{Keys: keys}}
+}
+
+func created(key, value string) *pb.MetadataEvent {
+	return event(pb.MetadataEvent_EVENT_TYPE_CREATED, key, value)
+}
+
+func deleted(key, value string) *pb.MetadataEvent {
+	return event(pb.MetadataEvent_EVENT_TYPE_DELETED, key, value)
+}
+
+func renamed(s *models.MetadataStoreV1, renames ...models.Rename) *models.MetadataStoreV1 {
+	s.Renames = renames
+	return s
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before *models.MetadataStoreV1
+		after  *models.MetadataStoreV1
+		want   []*pb.MetadataEvent
+	}{
+		{"empty", store(), store(), nil},
+		{"unchanged", store(models.Key{Name: "foo", Values: []string{"bar"}}), store(models.Key{Name: "foo", Values: []string{"bar"}}), nil},
+		{
+			"created",
+			store(models.Key{Name: "foo", Values: []string{"bar"}}),
+			store(models.Key{Name: "foo", Values: []string{"bar", "rab"}}, models.Key{Name: "new", Values: []string{"v"}}),
+			[]*pb.MetadataEvent{created("foo", "rab"), created("new", "v")},
+		},
+		{
+			"deleted",
+			store(models.Key{Name: "foo", Values: []string{"bar", "rab"}}),
+			store(models.Key{Name: "foo", Values: []string{}}),
+			[]*pb.MetadataEvent{deleted("foo", "bar"), deleted("foo", "rab")},
+		},
+		{
+			"moved",
+			store(models.Key{Name: "foo", Values: []string{"bar"}}),
+			store(models.Key{Name: "other", Values: []string{"bar"}}),
+			[]*pb.MetadataEvent{deleted("foo", "bar"), created("other", "bar")},
+		},
+		{
+			"renamed",
+			store(models.Key{Name: "customer", Values: []string{"culvrs", "culvers", "acme"}}),
+			renamed(store(models.Key{Name: "customer", Values: []string{"culvers"}}),
+				models.Rename{From: &pb.Metadata{Key: "customer", Value: "culvrs"}, To: &pb.Metadata{Key: "customer", Value: "culvers"}}),
+			[]*pb.MetadataEvent{deleted("customer", "acme"), {
+				Type:     pb.MetadataEvent_EVENT_TYPE_RENAMED,
+				Metadata: &pb.Metadata{Key: "customer", Value: "culvers"},
+				Previous: &pb.Metadata{Key: "customer", Value: "culvrs"},
+			}},
+		},
+		{
+			"recased",
+			store(models.Key{Name: "customer", Values: []string{"culvers"}}),
+			renamed(store(models.Key{Name: "customer", Values: []string{"culvers"}, Display: "Customer"}),
+				models.Rename{From: &pb.Metadata{Key: "customer", Value: "culvers"}, To: &pb.Metadata{Key: "Customer", Value: "culvers"}}),
+			[]*pb.MetadataEvent{{
+				Type:     pb.MetadataEvent_EVENT_TYPE_RENAMED,
+				Metadata: &pb.Metadata{Key: "Customer", Value: "culvers"},
+				Previous: &pb.Metadata{Key: "customer", Value: "culvers"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diff(tt.before, tt.after))
+		})
+	}
+}
+
+// commit publishes the change from before to after as the next revision of the project.
+func commit(h *Hub, revision *uint64, before, after *models.MetadataStoreV1) {
+	before.Revision = *revision
+	*revision++
+	after.Revision = *revision
+	h.Commit(project, before, after)
+}
+
+func TestHub_Subscribe(t *testing.T) {
+	h := NewHub(2)
+	revision := uint64(0)
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"bar"}}))
+
+	sub, backlog, err := h.Subscribe(project, revision, 0)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+
+	// commits without value changes are not published
+	commit(h, &revision, store(), store())
+	commit(h, &revision, store(models.Key{Name: "foo", Values: []string{"bar"}}), store())
+	assert.Equal(t, &pb.WatchMetadataResponse{Revision: 3, Events: []*pb.MetadataEvent{deleted("foo", "bar")}}, <-sub.C())
+
+	// other projects are not delivered
+	other := store(models.Key{Name: "foo", Values: []string{"bar"}})
+	other.Revision = 1
+	h.Commit("p2", store(), other)
+	assert.Empty(t, sub.C())
+
+	sub.Close()
+	_, ok := <-sub.C()
+	assert.False(t, ok)
+	assert.NoError(t, sub.Err())
+	sub.Close()
+}
+
+func TestHub_Resume(t *testing.T) {
+	h := NewHub(2)
+	revision := uint64(0)
+	for _, v := range []string{"a", "b", "c"} {
+		commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{v}}))
+	}
+
+	_, backlog, err := h.Subscribe(project, revision, 1)
+	require.NoError(t, err)
+	require.Len(t, backlog, 2)
+	assert.Equal(t, uint64(2), backlog[0].Revision)
+	assert.Equal(t, uint64(3), backlog[1].Revision)
+
+	_, backlog, err = h.Subscribe(project, revision, 3)
+	assert.NoError(t, err)
+	assert.Empty(t, backlog)
+
+	// with a history of 2, revision 2 is the oldest that can be resumed
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"d"}}))
+	_, _, err = h.Subscribe(project, revision, 1)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+	_, _, err = h.Subscribe(project, revision, 10)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestHub_ResumeUnpublished(t *testing.T) {
+	h := NewHub(1)
+	revision := uint64(0)
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"a"}}))
+	// e.g. an owner acquiring a reference to an existing value
+	commit(h, &revision, store(), store())
+	commit(h, &revision, store(), store())
+
+	_, backlog, err := h.Subscribe(project, revision, 1)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+	_, backlog, err = h.Subscribe(project, revision, 0)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"b"}}))
+	_, backlog, err = h.Subscribe(project, revision, 2)
+	require.NoError(t, err)
+	require.Len(t, backlog, 1)
+	assert.Equal(t, uint64(4), backlog[0].Revision)
+
+	// with a history of 1, the change of revision 1 is gone
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"c"}}))
+	_, _, err = h.Subscribe(project, revision, 3)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, backlog, err = h.Subscribe(project, revision, 4)
+	require.NoError(t, err)
+	require.Len(t, backlog, 1)
+	assert.Equal(t, uint64(5), backlog[0].Revision)
+}
+
+func TestHub_ResumeAfterReset(t *testing.T) {
+	h := NewHub(DefaultHistory)
+	revision := uint64(0)
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"a"}}))
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"b"}}))
+
+	// the project was deleted and created again, the old revisions mean nothing anymore
+	revision = 0
+	commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"c"}}))
+	_, _, err := h.Subscribe(project, revision, 2)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, backlog, err := h.Subscribe(project, revision, 1)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+
+	// the store is ahead of the hub, e.g. after a restart
+	_, _, err = h.Subscribe(project, 5, 1)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, backlog, err = h.Subscribe(project, 5, 5)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+}
+
+func TestHub_SlowSubscriber(t *testing.T) {
+	h := NewHub(DefaultHistory)
+	revision := uint64(0)
+	sub, _, err := h.Subscribe(project, revision, 0)
+	require.NoError(t, err)
+
+	for i := 0; i <= subscriptionBuffer; i++ {
+		if i%2 == 0 {
+			commit(h, &revision, store(), store(models.Key{Name: "foo", Values: []string{"bar"}}))
+		} else {
+			commit(h, &revision, store(models.Key{Name: "foo", Values: []string{"bar"}}), store())
+		}
+	}
+
+	received := 0
+	for range sub.C() {
+		received++
+	}
+	assert.Equal(t, subscriptionBuffer, received)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(sub.Err()))
+	sub.Close()
+}
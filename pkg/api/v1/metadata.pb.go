@@ -25,14 +25,129 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ValidationProfile selects the characters and length accepted in keys and values.
+type ValidationProfile int32
+
+const (
+	// VALIDATION_PROFILE_UNSPECIFIED uses the profile of the project, k8s-label unless set otherwise.
+	ValidationProfile_VALIDATION_PROFILE_UNSPECIFIED ValidationProfile = 0
+	// VALIDATION_PROFILE_K8S_LABEL accepts Kubernetes label compatible values: up to 40 ASCII letters, digits and dashes.
+	ValidationProfile_VALIDATION_PROFILE_K8S_LABEL ValidationProfile = 1
+	// VALIDATION_PROFILE_EXTENDED accepts up to 253 Unicode letters, digits, spaces, dots, underscores and dashes.
+	ValidationProfile_VALIDATION_PROFILE_EXTENDED ValidationProfile = 2
+)
+
+// Enum value maps for ValidationProfile.
+var (
+	ValidationProfile_name = map[int32]string{
+		0: "VALIDATION_PROFILE_UNSPECIFIED",
+		1: "VALIDATION_PROFILE_K8S_LABEL",
+		2: "VALIDATION_PROFILE_EXTENDED",
+	}
+	ValidationProfile_value = map[string]int32{
+		"VALIDATION_PROFILE_UNSPECIFIED": 0,
+		"VALIDATION_PROFILE_K8S_LABEL":   1,
+		"VALIDATION_PROFILE_EXTENDED":    2,
+	}
+)
+
+func (x ValidationProfile) Enum() *ValidationProfile {
+	p := new(ValidationProfile)
+	*p = x
+	return p
+}
+
+func (x ValidationProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_metadata_proto_enumTypes[0].Descriptor()
+}
+
+func (ValidationProfile) Type() protoreflect.EnumType {
+	return &file_v1_metadata_proto_enumTypes[0]
+}
+
+func (x ValidationProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationProfile.Descriptor instead.
+func (ValidationProfile) EnumDescriptor() ([]byte, []int) {
+	return file_v1_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+type KeySchema_Type int32
+
+const (
+	// TYPE_UNSPECIFIED is the same as TYPE_FREE_FORM.
+	KeySchema_TYPE_UNSPECIFIED KeySchema_Type = 0
+	// TYPE_FREE_FORM accepts any value.
+	KeySchema_TYPE_FREE_FORM KeySchema_Type = 1
+	// TYPE_ENUM only accepts the allowed_values.
+	KeySchema_TYPE_ENUM KeySchema_Type = 2
+	// TYPE_REGEX only accepts the values matching the pattern.
+	KeySchema_TYPE_REGEX KeySchema_Type = 3
+)
+
+// Enum value maps for KeySchema_Type.
+var (
+	KeySchema_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_FREE_FORM",
+		2: "TYPE_ENUM",
+		3: "TYPE_REGEX",
+	}
+	KeySchema_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_FREE_FORM":   1,
+		"TYPE_ENUM":        2,
+		"TYPE_REGEX":       3,
+	}
+)
+
+func (x KeySchema_Type) Enum() *KeySchema_Type {
+	p := new(KeySchema_Type)
+	*p = x
+	return p
+}
+
+func (x KeySchema_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeySchema_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_metadata_proto_enumTypes[1].Descriptor()
+}
+
+func (KeySchema_Type) Type() protoreflect.EnumType {
+	return &file_v1_metadata_proto_enumTypes[1]
+}
+
+func (x KeySchema_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeySchema_Type.Descriptor instead.
+func (KeySchema_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_metadata_proto_rawDescGZIP(), []int{2, 0}
+}
+
 // Metadata represents a single value of metadata.
 type Metadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// key and value are matched case-insensitively, they are stored with the casing first written for display.
+	// The characters and length accepted depend on the validation profile of the key, see ValidationProfile.
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// owner acquires (on create) or releases (on delete) a reference to the value, e.g. app-orch/deployment/123.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// source is the component writing the value, e.g. app-orch. It defaults to the product of the client User-Agent.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -81,7 +196,21 @@ func (x *Metadata) GetValue() string {
 	return ""
 }
 
-// StoredMetadata represents all stored metadata values for a given key.
+func (x *Metadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Metadata) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// StoredMetadata represents all stored metadata values for a given key, in their normalized (lowercase) form.
 type StoredMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -89,6 +218,12 @@ type StoredMetadata struct {
 
 	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// ref_counts holds the number of owners referencing each value, values without owners are omitted.
+	RefCounts map[string]uint32 `protobuf:"bytes,3,rep,name=ref_counts,json=refCounts,proto3" json:"ref_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// display_key is the key as first written, when its casing differs from the normalized key.
+	DisplayKey string `protobuf:"bytes,4,opt,name=display_key,json=displayKey,proto3" json:"display_key,omitempty"`
+	// display_values holds the values as first written, for the values whose casing differs from the normalized value.
+	DisplayValues map[string]string `protobuf:"bytes,5,rep,name=display_values,json=displayValues,proto3" json:"display_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *StoredMetadata) Reset() {
@@ -137,6 +272,120 @@ func (x *StoredMetadata) GetValues() []string {
 	return nil
 }
 
+func (x *StoredMetadata) GetRefCounts() map[string]uint32 {
+	if x != nil {
+		return x.RefCounts
+	}
+	return nil
+}
+
+func (x *StoredMetadata) GetDisplayKey() string {
+	if x != nil {
+		return x.DisplayKey
+	}
+	return ""
+}
+
+func (x *StoredMetadata) GetDisplayValues() map[string]string {
+	if x != nil {
+		return x.DisplayValues
+	}
+	return nil
+}
+
+// KeySchema constrains the values of a key.
+type KeySchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type KeySchema_Type `protobuf:"varint,2,opt,name=type,proto3,enum=v1.KeySchema_Type" json:"type,omitempty"`
+	// allowed_values are the only values accepted by an enum schema.
+	AllowedValues []string `protobuf:"bytes,3,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// pattern is the RE2 regular expression a value must fully match in a regex schema. It is matched
+	// against the value as written, so it is case-sensitive unlike the allowed_values.
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// max_values is the maximum number of values of the key, zero for no limit.
+	MaxValues uint32 `protobuf:"varint,5,opt,name=max_values,json=maxValues,proto3" json:"max_values,omitempty"`
+	// profile validates the key and its values, instead of the profile of the project.
+	Profile ValidationProfile `protobuf:"varint,6,opt,name=profile,proto3,enum=v1.ValidationProfile" json:"profile,omitempty"`
+}
+
+func (x *KeySchema) Reset() {
+	*x = KeySchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_metadata_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeySchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySchema) ProtoMessage() {}
+
+func (x *KeySchema) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_metadata_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySchema.ProtoReflect.Descriptor instead.
+func (*KeySchema) Descriptor() ([]byte, []int) {
+	return file_v1_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeySchema) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeySchema) GetType() KeySchema_Type {
+	if x != nil {
+		return x.Type
+	}
+	return KeySchema_TYPE_UNSPECIFIED
+}
+
+func (x *KeySchema) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *KeySchema) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *KeySchema) GetMaxValues() uint32 {
+	if x != nil {
+		return x.MaxValues
+	}
+	return 0
+}
+
+func (x *KeySchema) GetProfile() ValidationProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return ValidationProfile_VALIDATION_PROFILE_UNSPECIFIED
+}
+
 var File_v1_metadata_proto protoreflect.FileDescriptor
 
 var file_v1_metadata_proto_rawDesc = []byte{
@@ -145,33 +394,83 @@ var file_v1_metadata_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
 	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01,
-	0x02, 0xfa, 0x42, 0x2e, 0x72, 0x2c, 0x10, 0x01, 0x18, 0x28, 0x32, 0x26, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30,
-	0x2c, 0x33, 0x38, 0x7d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x30, 0x2c, 0x31,
-	0x7d, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x42, 0x2e, 0x72,
-	0x2c, 0x10, 0x01, 0x18, 0x28, 0x32, 0x26, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
-	0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x33, 0x38, 0x7d, 0x5b,
-	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x30, 0x2c, 0x31, 0x7d, 0x24, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x42, 0x2e, 0x72, 0x2c, 0x10, 0x01,
-	0x18, 0x28, 0x32, 0x26, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d,
-	0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x33, 0x38, 0x7d, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x30, 0x2c, 0x31, 0x7d, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x1c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x7e, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x2d, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x02,
-	0x56, 0x31, 0xca, 0x02, 0x02, 0x56, 0x31, 0xe2, 0x02, 0x0e, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x02, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x22, 0xea, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xe2, 0x41, 0x01,
+	0x02, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xfa, 0x42, 0x36, 0x72, 0x34, 0x18, 0xfd, 0x01, 0x32,
+	0x2c, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x3a, 0x2f, 0x2d, 0x5d, 0x2a, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x24, 0xd0, 0x01, 0x01,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x42, 0x2a, 0x72, 0x28, 0x18, 0x3f,
+	0x32, 0x21, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x29, 0x3f, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x93,
+	0x03, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xe2, 0x41, 0x01, 0x02, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x46, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x52, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1, 0x02, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xe2, 0x41, 0x01, 0x02, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52,
+	0x45, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x2a, 0x7a, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a,
+	0x1e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4b, 0x38, 0x53, 0x5f, 0x4c, 0x41, 0x42, 0x45,
+	0x4c, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x02, 0x42, 0x7e, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x2d, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x6f,
+	0x72, 0x63, 0x68, 0x2d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x62, 0x72, 0x6f,
+	0x6b, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x02, 0x56, 0x31, 0xca, 0x02, 0x02, 0x56, 0x31, 0xe2, 0x02,
+	0x0e, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x02, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -186,17 +485,27 @@ func file_v1_metadata_proto_rawDescGZIP() []byte {
 	return file_v1_metadata_proto_rawDescData
 }
 
-var file_v1_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_v1_metadata_proto_goTypes = []interface{}{
-	(*Metadata)(nil),       // 0: v1.Metadata
-	(*StoredMetadata)(nil), // 1: v1.StoredMetadata
+	(ValidationProfile)(0), // 0: v1.ValidationProfile
+	(KeySchema_Type)(0),    // 1: v1.KeySchema.Type
+	(*Metadata)(nil),       // 2: v1.Metadata
+	(*StoredMetadata)(nil), // 3: v1.StoredMetadata
+	(*KeySchema)(nil),      // 4: v1.KeySchema
+	nil,                    // 5: v1.StoredMetadata.RefCountsEntry
+	nil,                    // 6: v1.StoredMetadata.DisplayValuesEntry
 }
 var file_v1_metadata_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5, // 0: v1.StoredMetadata.ref_counts:type_name -> v1.StoredMetadata.RefCountsEntry
+	6, // 1: v1.StoredMetadata.display_values:type_name -> v1.StoredMetadata.DisplayValuesEntry
+	1, // 2: v1.KeySchema.type:type_name -> v1.KeySchema.Type
+	0, // 3: v1.KeySchema.profile:type_name -> v1.ValidationProfile
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_metadata_proto_init() }
@@ -229,19 +538,32 @@ func file_v1_metadata_proto_init() {
 				return nil
 			}
 		}
+		file_v1_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeySchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_metadata_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_v1_metadata_proto_goTypes,
 		DependencyIndexes: file_v1_metadata_proto_depIdxs,
+		EnumInfos:         file_v1_metadata_proto_enumTypes,
 		MessageInfos:      file_v1_metadata_proto_msgTypes,
 	}.Build()
 	File_v1_metadata_proto = out.File
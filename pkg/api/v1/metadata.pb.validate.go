@@ -61,10 +61,10 @@ func (m *Metadata) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 40 {
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
 		err := MetadataValidationError{
 			field:  "Key",
-			reason: "value length must be between 1 and 40 runes, inclusive",
+			reason: "value length must be between 1 and 253 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -72,10 +72,10 @@ func (m *Metadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_Metadata_Key_Pattern.MatchString(m.GetKey()) {
+	if l := utf8.RuneCountInString(m.GetValue()); l < 1 || l > 253 {
 		err := MetadataValidationError{
-			field:  "Key",
-			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$\"",
+			field:  "Value",
+			reason: "value length must be between 1 and 253 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -83,26 +83,56 @@ func (m *Metadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetValue()); l < 1 || l > 40 {
-		err := MetadataValidationError{
-			field:  "Value",
-			reason: "value length must be between 1 and 40 runes, inclusive",
+	if m.GetOwner() != "" {
+
+		if utf8.RuneCountInString(m.GetOwner()) > 253 {
+			err := MetadataValidationError{
+				field:  "Owner",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+		if !_Metadata_Owner_Pattern.MatchString(m.GetOwner()) {
+			err := MetadataValidationError{
+				field:  "Owner",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9]([A-Za-z0-9._:/-]*[A-Za-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
-	if !_Metadata_Value_Pattern.MatchString(m.GetValue()) {
-		err := MetadataValidationError{
-			field:  "Value",
-			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$\"",
+	if m.GetSource() != "" {
+
+		if utf8.RuneCountInString(m.GetSource()) > 63 {
+			err := MetadataValidationError{
+				field:  "Source",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+		if !_Metadata_Source_Pattern.MatchString(m.GetSource()) {
+			err := MetadataValidationError{
+				field:  "Source",
+				reason: "value does not match regex pattern \"^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
@@ -182,9 +212,9 @@ var _ interface {
 	ErrorName() string
 } = MetadataValidationError{}
 
-var _Metadata_Key_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$")
+var _Metadata_Owner_Pattern = regexp.MustCompile("^[A-Za-z0-9]([A-Za-z0-9._:/-]*[A-Za-z0-9])?$")
 
-var _Metadata_Value_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$")
+var _Metadata_Source_Pattern = regexp.MustCompile("^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$")
 
 // Validate checks the field values on StoredMetadata with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -208,10 +238,10 @@ func (m *StoredMetadata) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 40 {
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
 		err := StoredMetadataValidationError{
 			field:  "Key",
-			reason: "value length must be between 1 and 40 runes, inclusive",
+			reason: "value length must be between 1 and 253 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -219,16 +249,11 @@ func (m *StoredMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_StoredMetadata_Key_Pattern.MatchString(m.GetKey()) {
-		err := StoredMetadataValidationError{
-			field:  "Key",
-			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RefCounts
+
+	// no validation rules for DisplayKey
+
+	// no validation rules for DisplayValues
 
 	if len(errors) > 0 {
 		return StoredMetadataMultiError(errors)
@@ -308,4 +333,129 @@ var _ interface {
 	ErrorName() string
 } = StoredMetadataValidationError{}
 
-var _StoredMetadata_Key_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$")
+// Validate checks the field values on KeySchema with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeySchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeySchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeySchemaMultiError, or nil
+// if none found.
+func (m *KeySchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeySchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := KeySchemaValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Type
+
+	if utf8.RuneCountInString(m.GetPattern()) > 1024 {
+		err := KeySchemaValidationError{
+			field:  "Pattern",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxValues
+
+	// no validation rules for Profile
+
+	if len(errors) > 0 {
+		return KeySchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeySchemaMultiError is an error wrapping multiple validation errors returned
+// by KeySchema.ValidateAll() if the designated constraints aren't met.
+type KeySchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeySchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeySchemaMultiError) AllErrors() []error { return m }
+
+// KeySchemaValidationError is the validation error returned by
+// KeySchema.Validate if the designated constraints aren't met.
+type KeySchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeySchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeySchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeySchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeySchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeySchemaValidationError) ErrorName() string { return "KeySchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeySchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeySchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeySchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeySchemaValidationError{}
@@ -223,6 +223,10 @@ func (m *CreateOrUpdateRequest) validate(all bool) error {
 		}
 	}
 
+	if m.ExpectedRevision != nil {
+		// no validation rules for ExpectedRevision
+	}
+
 	if len(errors) > 0 {
 		return CreateOrUpdateRequestMultiError(errors)
 	}
@@ -359,6 +363,78 @@ func (m *MetadataResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetadataResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetadataResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetadataResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExisting() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetadataResponseValidationError{
+						field:  fmt.Sprintf("Existing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetadataResponseValidationError{
+						field:  fmt.Sprintf("Existing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetadataResponseValidationError{
+					field:  fmt.Sprintf("Existing[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Revision
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return MetadataResponseMultiError(errors)
 	}
@@ -437,44 +513,83 @@ var _ interface {
 	ErrorName() string
 } = MetadataResponseValidationError{}
 
-// Validate checks the field values on DeleteProjectRequest with the rules
+// Validate checks the field values on GetMetadataRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRequest) Validate() error {
+func (m *GetMetadataRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// ValidateAll checks the field values on GetMetadataRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProjectRequestMultiError, or nil if none found.
-func (m *DeleteProjectRequest) ValidateAll() error {
+// GetMetadataRequestMultiError, or nil if none found.
+func (m *GetMetadataRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRequest) validate(all bool) error {
+func (m *GetMetadataRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetSource() != "" {
+
+		if utf8.RuneCountInString(m.GetSource()) > 63 {
+			err := GetMetadataRequestValidationError{
+				field:  "Source",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetMetadataRequest_Source_Pattern.MatchString(m.GetSource()) {
+			err := GetMetadataRequestValidationError{
+				field:  "Source",
+				reason: "value does not match regex pattern \"^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPageSize() > 1000 {
+		err := GetMetadataRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
 
 	if len(errors) > 0 {
-		return DeleteProjectRequestMultiError(errors)
+		return GetMetadataRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProjectRequestMultiError []error
+// GetMetadataRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMetadataRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMetadataRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRequestMultiError) Error() string {
+func (m GetMetadataRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -483,11 +598,11 @@ func (m DeleteProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+func (m GetMetadataRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRequestValidationError is the validation error returned by
-// DeleteProjectRequest.Validate if the designated constraints aren't met.
-type DeleteProjectRequestValidationError struct {
+// GetMetadataRequestValidationError is the validation error returned by
+// GetMetadataRequest.Validate if the designated constraints aren't met.
+type GetMetadataRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -495,24 +610,24 @@ type DeleteProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+func (e GetMetadataRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+func (e GetMetadataRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+func (e GetMetadataRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+func (e GetMetadataRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRequestValidationError) ErrorName() string {
-	return "DeleteProjectRequestValidationError"
+func (e GetMetadataRequestValidationError) ErrorName() string {
+	return "GetMetadataRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRequestValidationError) Error() string {
+func (e GetMetadataRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -524,14 +639,14 @@ func (e DeleteProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRequest.%s: %s%s",
+		"invalid %sGetMetadataRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRequestValidationError{}
+var _ error = GetMetadataRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -539,4 +654,3266 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRequestValidationError{}
+} = GetMetadataRequestValidationError{}
+
+var _GetMetadataRequest_Source_Pattern = regexp.MustCompile("^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$")
+
+// Validate checks the field values on DeleteKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteKeyRequestMultiError, or nil if none found.
+func (m *DeleteKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := DeleteKeyRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteKeyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteKeyRequestValidationError is the validation error returned by
+// DeleteKeyRequest.Validate if the designated constraints aren't met.
+type DeleteKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteKeyRequestValidationError) ErrorName() string { return "DeleteKeyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteKeyRequestValidationError{}
+
+// Validate checks the field values on RenameKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameKeyRequestMultiError, or nil if none found.
+func (m *RenameKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := RenameKeyRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewKey()); l < 1 || l > 253 {
+		err := RenameKeyRequestValidationError{
+			field:  "NewKey",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameKeyRequestMultiError) AllErrors() []error { return m }
+
+// RenameKeyRequestValidationError is the validation error returned by
+// RenameKeyRequest.Validate if the designated constraints aren't met.
+type RenameKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameKeyRequestValidationError) ErrorName() string { return "RenameKeyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenameKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameKeyRequestValidationError{}
+
+// Validate checks the field values on MergeValuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeValuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeValuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeValuesRequestMultiError, or nil if none found.
+func (m *MergeValuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeValuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := MergeValuesRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetValues()) < 1 {
+		err := MergeValuesRequestValidationError{
+			field:  "Values",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetInto()); l < 1 || l > 253 {
+		err := MergeValuesRequestValidationError{
+			field:  "Into",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeValuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeValuesRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeValuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeValuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeValuesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeValuesRequestMultiError) AllErrors() []error { return m }
+
+// MergeValuesRequestValidationError is the validation error returned by
+// MergeValuesRequest.Validate if the designated constraints aren't met.
+type MergeValuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeValuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeValuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeValuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeValuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeValuesRequestValidationError) ErrorName() string {
+	return "MergeValuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeValuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeValuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeValuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeValuesRequestValidationError{}
+
+// Validate checks the field values on DeleteKeySchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteKeySchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteKeySchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteKeySchemaRequestMultiError, or nil if none found.
+func (m *DeleteKeySchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteKeySchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := DeleteKeySchemaRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteKeySchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteKeySchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteKeySchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteKeySchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteKeySchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteKeySchemaRequestMultiError) AllErrors() []error { return m }
+
+// DeleteKeySchemaRequestValidationError is the validation error returned by
+// DeleteKeySchemaRequest.Validate if the designated constraints aren't met.
+type DeleteKeySchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteKeySchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteKeySchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteKeySchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteKeySchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteKeySchemaRequestValidationError) ErrorName() string {
+	return "DeleteKeySchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteKeySchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteKeySchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteKeySchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteKeySchemaRequestValidationError{}
+
+// Validate checks the field values on ListKeySchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKeySchemasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKeySchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKeySchemasRequestMultiError, or nil if none found.
+func (m *ListKeySchemasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKeySchemasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListKeySchemasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKeySchemasRequestMultiError is an error wrapping multiple validation
+// errors returned by ListKeySchemasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListKeySchemasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKeySchemasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKeySchemasRequestMultiError) AllErrors() []error { return m }
+
+// ListKeySchemasRequestValidationError is the validation error returned by
+// ListKeySchemasRequest.Validate if the designated constraints aren't met.
+type ListKeySchemasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKeySchemasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKeySchemasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKeySchemasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKeySchemasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKeySchemasRequestValidationError) ErrorName() string {
+	return "ListKeySchemasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKeySchemasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKeySchemasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKeySchemasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKeySchemasRequestValidationError{}
+
+// Validate checks the field values on ListKeySchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKeySchemasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKeySchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKeySchemasResponseMultiError, or nil if none found.
+func (m *ListKeySchemasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKeySchemasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListKeySchemasResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListKeySchemasResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListKeySchemasResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListKeySchemasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKeySchemasResponseMultiError is an error wrapping multiple validation
+// errors returned by ListKeySchemasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListKeySchemasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKeySchemasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKeySchemasResponseMultiError) AllErrors() []error { return m }
+
+// ListKeySchemasResponseValidationError is the validation error returned by
+// ListKeySchemasResponse.Validate if the designated constraints aren't met.
+type ListKeySchemasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKeySchemasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKeySchemasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKeySchemasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKeySchemasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKeySchemasResponseValidationError) ErrorName() string {
+	return "ListKeySchemasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKeySchemasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKeySchemasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKeySchemasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKeySchemasResponseValidationError{}
+
+// Validate checks the field values on GetValidationProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetValidationProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetValidationProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetValidationProfileRequestMultiError, or nil if none found.
+func (m *GetValidationProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetValidationProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetValidationProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetValidationProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetValidationProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetValidationProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetValidationProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetValidationProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetValidationProfileRequestValidationError is the validation error returned
+// by GetValidationProfileRequest.Validate if the designated constraints
+// aren't met.
+type GetValidationProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetValidationProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetValidationProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetValidationProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetValidationProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetValidationProfileRequestValidationError) ErrorName() string {
+	return "GetValidationProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetValidationProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetValidationProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetValidationProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetValidationProfileRequestValidationError{}
+
+// Validate checks the field values on SetValidationProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetValidationProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetValidationProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetValidationProfileRequestMultiError, or nil if none found.
+func (m *SetValidationProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetValidationProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Profile
+
+	if len(errors) > 0 {
+		return SetValidationProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetValidationProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by SetValidationProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetValidationProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetValidationProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetValidationProfileRequestMultiError) AllErrors() []error { return m }
+
+// SetValidationProfileRequestValidationError is the validation error returned
+// by SetValidationProfileRequest.Validate if the designated constraints
+// aren't met.
+type SetValidationProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetValidationProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetValidationProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetValidationProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetValidationProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetValidationProfileRequestValidationError) ErrorName() string {
+	return "SetValidationProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetValidationProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetValidationProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetValidationProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetValidationProfileRequestValidationError{}
+
+// Validate checks the field values on ValidationProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidationProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidationProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidationProfileResponseMultiError, or nil if none found.
+func (m *ValidationProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidationProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Profile
+
+	if len(errors) > 0 {
+		return ValidationProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidationProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidationProfileResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ValidationProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidationProfileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidationProfileResponseMultiError) AllErrors() []error { return m }
+
+// ValidationProfileResponseValidationError is the validation error returned by
+// ValidationProfileResponse.Validate if the designated constraints aren't met.
+type ValidationProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidationProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidationProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidationProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidationProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidationProfileResponseValidationError) ErrorName() string {
+	return "ValidationProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidationProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidationProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidationProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidationProfileResponseValidationError{}
+
+// Validate checks the field values on GetKeyRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetKeyRequestMultiError, or
+// nil if none found.
+func (m *GetKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 253 {
+		err := GetKeyRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSource() != "" {
+
+		if utf8.RuneCountInString(m.GetSource()) > 63 {
+			err := GetKeyRequestValidationError{
+				field:  "Source",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetKeyRequest_Source_Pattern.MatchString(m.GetSource()) {
+			err := GetKeyRequestValidationError{
+				field:  "Source",
+				reason: "value does not match regex pattern \"^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for OrderBy
+
+	if len(errors) > 0 {
+		return GetKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by GetKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKeyRequestMultiError) AllErrors() []error { return m }
+
+// GetKeyRequestValidationError is the validation error returned by
+// GetKeyRequest.Validate if the designated constraints aren't met.
+type GetKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKeyRequestValidationError) ErrorName() string { return "GetKeyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKeyRequestValidationError{}
+
+var _GetKeyRequest_Source_Pattern = regexp.MustCompile("^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$")
+
+// Validate checks the field values on GetKeyResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKeyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetKeyResponseMultiError,
+// or nil if none found.
+func (m *GetKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetKeyResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetKeyResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetKeyResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return GetKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKeyResponseMultiError is an error wrapping multiple validation errors
+// returned by GetKeyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKeyResponseMultiError) AllErrors() []error { return m }
+
+// GetKeyResponseValidationError is the validation error returned by
+// GetKeyResponse.Validate if the designated constraints aren't met.
+type GetKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKeyResponseValidationError) ErrorName() string { return "GetKeyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKeyResponseValidationError{}
+
+// Validate checks the field values on ListKeysRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKeysRequestMultiError, or nil if none found.
+func (m *ListKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSource() != "" {
+
+		if utf8.RuneCountInString(m.GetSource()) > 63 {
+			err := ListKeysRequestValidationError{
+				field:  "Source",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ListKeysRequest_Source_Pattern.MatchString(m.GetSource()) {
+			err := ListKeysRequestValidationError{
+				field:  "Source",
+				reason: "value does not match regex pattern \"^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for OrderBy
+
+	if len(errors) > 0 {
+		return ListKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListKeysRequestValidationError is the validation error returned by
+// ListKeysRequest.Validate if the designated constraints aren't met.
+type ListKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKeysRequestValidationError) ErrorName() string { return "ListKeysRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKeysRequestValidationError{}
+
+var _ListKeysRequest_Source_Pattern = regexp.MustCompile("^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$")
+
+// Validate checks the field values on KeySummary with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeySummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeySummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeySummaryMultiError, or
+// nil if none found.
+func (m *KeySummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeySummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for ValueCount
+
+	// no validation rules for DisplayKey
+
+	if len(errors) > 0 {
+		return KeySummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeySummaryMultiError is an error wrapping multiple validation errors
+// returned by KeySummary.ValidateAll() if the designated constraints aren't met.
+type KeySummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeySummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeySummaryMultiError) AllErrors() []error { return m }
+
+// KeySummaryValidationError is the validation error returned by
+// KeySummary.Validate if the designated constraints aren't met.
+type KeySummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeySummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeySummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeySummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeySummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeySummaryValidationError) ErrorName() string { return "KeySummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeySummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeySummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeySummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeySummaryValidationError{}
+
+// Validate checks the field values on ListKeysResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKeysResponseMultiError, or nil if none found.
+func (m *ListKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return ListKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKeysResponseMultiError is an error wrapping multiple validation errors
+// returned by ListKeysResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListKeysResponseValidationError is the validation error returned by
+// ListKeysResponse.Validate if the designated constraints aren't met.
+type ListKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKeysResponseValidationError) ErrorName() string { return "ListKeysResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKeysResponseValidationError{}
+
+// Validate checks the field values on SearchMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMetadataRequestMultiError, or nil if none found.
+func (m *SearchMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 253 {
+		err := SearchMetadataRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Key
+
+	// no validation rules for Mode
+
+	if m.GetLimit() > 1000 {
+		err := SearchMetadataRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSource() != "" {
+
+		if utf8.RuneCountInString(m.GetSource()) > 63 {
+			err := SearchMetadataRequestValidationError{
+				field:  "Source",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SearchMetadataRequest_Source_Pattern.MatchString(m.GetSource()) {
+			err := SearchMetadataRequestValidationError{
+				field:  "Source",
+				reason: "value does not match regex pattern \"^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMetadataRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMetadataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMetadataRequestMultiError) AllErrors() []error { return m }
+
+// SearchMetadataRequestValidationError is the validation error returned by
+// SearchMetadataRequest.Validate if the designated constraints aren't met.
+type SearchMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMetadataRequestValidationError) ErrorName() string {
+	return "SearchMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMetadataRequestValidationError{}
+
+var _SearchMetadataRequest_Source_Pattern = regexp.MustCompile("^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$")
+
+// Validate checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMatchMultiError, or
+// nil if none found.
+func (m *SearchMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchMatchValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchMatchValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchMatchValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for Distance
+
+	// no validation rules for DisplayKey
+
+	// no validation rules for DisplayValue
+
+	if len(errors) > 0 {
+		return SearchMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMatchMultiError is an error wrapping multiple validation errors
+// returned by SearchMatch.ValidateAll() if the designated constraints aren't met.
+type SearchMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMatchMultiError) AllErrors() []error { return m }
+
+// SearchMatchValidationError is the validation error returned by
+// SearchMatch.Validate if the designated constraints aren't met.
+type SearchMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMatchValidationError) ErrorName() string { return "SearchMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMatchValidationError{}
+
+// Validate checks the field values on SearchMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMetadataResponseMultiError, or nil if none found.
+func (m *SearchMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMetadataResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMetadataResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMetadataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMetadataResponseMultiError) AllErrors() []error { return m }
+
+// SearchMetadataResponseValidationError is the validation error returned by
+// SearchMetadataResponse.Validate if the designated constraints aren't met.
+type SearchMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMetadataResponseValidationError) ErrorName() string {
+	return "SearchMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMetadataResponseValidationError{}
+
+// Validate checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRequestMultiError, or nil if none found.
+func (m *DeleteProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProjectRequestValidationError is the validation error returned by
+// DeleteProjectRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectRequestValidationError) ErrorName() string {
+	return "DeleteProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectRequestValidationError{}
+
+// Validate checks the field values on WatchMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMetadataRequestMultiError, or nil if none found.
+func (m *WatchMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Snapshot
+
+	// no validation rules for ResumeRevision
+
+	if len(errors) > 0 {
+		return WatchMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchMetadataRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchMetadataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchMetadataRequestMultiError) AllErrors() []error { return m }
+
+// WatchMetadataRequestValidationError is the validation error returned by
+// WatchMetadataRequest.Validate if the designated constraints aren't met.
+type WatchMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchMetadataRequestValidationError) ErrorName() string {
+	return "WatchMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchMetadataRequestValidationError{}
+
+// Validate checks the field values on MetadataEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetadataEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetadataEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetadataEventMultiError, or
+// nil if none found.
+func (m *MetadataEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetadataEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetadataEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetadataEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetadataEventValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrevious()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetadataEventValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetadataEventValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrevious()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetadataEventValidationError{
+				field:  "Previous",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetadataEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetadataEventMultiError is an error wrapping multiple validation errors
+// returned by MetadataEvent.ValidateAll() if the designated constraints
+// aren't met.
+type MetadataEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetadataEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetadataEventMultiError) AllErrors() []error { return m }
+
+// MetadataEventValidationError is the validation error returned by
+// MetadataEvent.Validate if the designated constraints aren't met.
+type MetadataEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetadataEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetadataEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetadataEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetadataEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetadataEventValidationError) ErrorName() string { return "MetadataEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetadataEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetadataEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetadataEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetadataEventValidationError{}
+
+// Validate checks the field values on WatchMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMetadataResponseMultiError, or nil if none found.
+func (m *WatchMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	for idx, item := range m.GetSnapshot() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Snapshot[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Snapshot[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchMetadataResponseValidationError{
+					field:  fmt.Sprintf("Snapshot[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchMetadataResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchMetadataResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchMetadataResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchMetadataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchMetadataResponseMultiError) AllErrors() []error { return m }
+
+// WatchMetadataResponseValidationError is the validation error returned by
+// WatchMetadataResponse.Validate if the designated constraints aren't met.
+type WatchMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchMetadataResponseValidationError) ErrorName() string {
+	return "WatchMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchMetadataResponseValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKey() != "" {
+
+		if utf8.RuneCountInString(m.GetKey()) > 253 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "Key",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSubject() != "" {
+
+		if utf8.RuneCountInString(m.GetSubject()) > 253 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "Subject",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetRpc() != "" {
+
+		if utf8.RuneCountInString(m.GetRpc()) > 63 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "Rpc",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() > 1000 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditChangeMultiError, or
+// nil if none found.
+func (m *AuditChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for DisplayBefore
+
+	// no validation rules for DisplayAfter
+
+	if len(errors) > 0 {
+		return AuditChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditChangeMultiError is an error wrapping multiple validation errors
+// returned by AuditChange.ValidateAll() if the designated constraints aren't met.
+type AuditChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditChangeMultiError) AllErrors() []error { return m }
+
+// AuditChangeValidationError is the validation error returned by
+// AuditChange.Validate if the designated constraints aren't met.
+type AuditChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditChangeValidationError) ErrorName() string { return "AuditChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditChangeValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Subject
+
+	// no validation rules for Username
+
+	// no validation rules for Client
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Rpc
+
+	// no validation rules for RequestId
+
+	// no validation rules for Decision
+
+	// no validation rules for Error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditEventValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditEventValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEventValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PreviousHash
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
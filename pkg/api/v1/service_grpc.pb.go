@@ -27,13 +27,41 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MetadataServiceClient interface {
-	// CreateOrUpdateMetadata creates or updates the specified metadata, returning the newly updates set.
+	// CreateOrUpdateMetadata creates or updates the specified metadata all-or-nothing, returning the newly updates set.
 	CreateOrUpdateMetadata(ctx context.Context, in *CreateOrUpdateRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
 	// Delete deletes the specified metadata, returning the newly updated set.
 	Delete(ctx context.Context, in *Metadata, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// DeleteKey deletes the specified key with all its values, returning the newly updated set.
+	DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// BatchDelete deletes the specified metadata all-or-nothing, returning the newly updated set.
+	BatchDelete(ctx context.Context, in *MetadataList, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// RenameKey moves all the values of a key to another key, merging them with the values already there. Admin only.
+	RenameKey(ctx context.Context, in *RenameKeyRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// MergeValues merges values of a key into another value of the key, e.g. to correct a typo. Admin only.
+	MergeValues(ctx context.Context, in *MergeValuesRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// SetKeySchema registers the schema constraining the values of a key, replacing the previous one. Admin only.
+	SetKeySchema(ctx context.Context, in *KeySchema, opts ...grpc.CallOption) (*KeySchema, error)
+	// DeleteKeySchema removes the schema of a key, its values are then free-form. Admin only.
+	DeleteKeySchema(ctx context.Context, in *DeleteKeySchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListKeySchemas retrieves the schemas registered in the active project.
+	ListKeySchemas(ctx context.Context, in *ListKeySchemasRequest, opts ...grpc.CallOption) (*ListKeySchemasResponse, error)
+	// GetValidationProfile retrieves the validation profile of the active project.
+	GetValidationProfile(ctx context.Context, in *GetValidationProfileRequest, opts ...grpc.CallOption) (*ValidationProfileResponse, error)
+	// SetValidationProfile selects the validation profile of the active project, provided the stored metadata satisfies it. Admin only.
+	SetValidationProfile(ctx context.Context, in *SetValidationProfileRequest, opts ...grpc.CallOption) (*ValidationProfileResponse, error)
 	// GetMetadata retrieves the most recently udpates set.
-	GetMetadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	// GetKey retrieves the values of a single key of the active project.
+	GetKey(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (*GetKeyResponse, error)
+	// ListKeys retrieves the keys of the active project with their number of values, without the values.
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SearchMetadata returns the keys and values of the active project matching a partial key or value, best matches first.
+	SearchMetadata(ctx context.Context, in *SearchMetadataRequest, opts ...grpc.CallOption) (*SearchMetadataResponse, error)
+	// WatchMetadata streams the changes of the metadata of the active project.
+	WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataService_WatchMetadataClient, error)
+	// ListAuditEvents returns the audit records of the changes to the metadata of the active project, most recent first. Admin only.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type metadataServiceClient struct {
@@ -62,7 +90,88 @@ func (c *metadataServiceClient) Delete(ctx context.Context, in *Metadata, opts .
 	return out, nil
 }
 
-func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error) {
+func (c *metadataServiceClient) DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/DeleteKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) BatchDelete(ctx context.Context, in *MetadataList, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RenameKey(ctx context.Context, in *RenameKeyRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/RenameKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) MergeValues(ctx context.Context, in *MergeValuesRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/MergeValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetKeySchema(ctx context.Context, in *KeySchema, opts ...grpc.CallOption) (*KeySchema, error) {
+	out := new(KeySchema)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/SetKeySchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DeleteKeySchema(ctx context.Context, in *DeleteKeySchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/DeleteKeySchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListKeySchemas(ctx context.Context, in *ListKeySchemasRequest, opts ...grpc.CallOption) (*ListKeySchemasResponse, error) {
+	out := new(ListKeySchemasResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/ListKeySchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetValidationProfile(ctx context.Context, in *GetValidationProfileRequest, opts ...grpc.CallOption) (*ValidationProfileResponse, error) {
+	out := new(ValidationProfileResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/GetValidationProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetValidationProfile(ctx context.Context, in *SetValidationProfileRequest, opts ...grpc.CallOption) (*ValidationProfileResponse, error) {
+	out := new(ValidationProfileResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/SetValidationProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
 	out := new(MetadataResponse)
 	err := c.cc.Invoke(ctx, "/v1.MetadataService/GetMetadata", in, out, opts...)
 	if err != nil {
@@ -71,6 +180,24 @@ func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *metadataServiceClient) GetKey(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (*GetKeyResponse, error) {
+	out := new(GetKeyResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/GetKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metadataServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/v1.MetadataService/DeleteProject", in, out, opts...)
@@ -80,17 +207,95 @@ func (c *metadataServiceClient) DeleteProject(ctx context.Context, in *DeletePro
 	return out, nil
 }
 
+func (c *metadataServiceClient) SearchMetadata(ctx context.Context, in *SearchMetadataRequest, opts ...grpc.CallOption) (*SearchMetadataResponse, error) {
+	out := new(SearchMetadataResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/SearchMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataService_WatchMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[0], "/v1.MetadataService/WatchMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metadataServiceWatchMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MetadataService_WatchMetadataClient interface {
+	Recv() (*WatchMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type metadataServiceWatchMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *metadataServiceWatchMetadataClient) Recv() (*WatchMetadataResponse, error) {
+	m := new(WatchMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metadataServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/v1.MetadataService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetadataServiceServer is the server API for MetadataService service.
 // All implementations should embed UnimplementedMetadataServiceServer
 // for forward compatibility
 type MetadataServiceServer interface {
-	// CreateOrUpdateMetadata creates or updates the specified metadata, returning the newly updates set.
+	// CreateOrUpdateMetadata creates or updates the specified metadata all-or-nothing, returning the newly updates set.
 	CreateOrUpdateMetadata(context.Context, *CreateOrUpdateRequest) (*MetadataResponse, error)
 	// Delete deletes the specified metadata, returning the newly updated set.
 	Delete(context.Context, *Metadata) (*MetadataResponse, error)
+	// DeleteKey deletes the specified key with all its values, returning the newly updated set.
+	DeleteKey(context.Context, *DeleteKeyRequest) (*MetadataResponse, error)
+	// BatchDelete deletes the specified metadata all-or-nothing, returning the newly updated set.
+	BatchDelete(context.Context, *MetadataList) (*MetadataResponse, error)
+	// RenameKey moves all the values of a key to another key, merging them with the values already there. Admin only.
+	RenameKey(context.Context, *RenameKeyRequest) (*MetadataResponse, error)
+	// MergeValues merges values of a key into another value of the key, e.g. to correct a typo. Admin only.
+	MergeValues(context.Context, *MergeValuesRequest) (*MetadataResponse, error)
+	// SetKeySchema registers the schema constraining the values of a key, replacing the previous one. Admin only.
+	SetKeySchema(context.Context, *KeySchema) (*KeySchema, error)
+	// DeleteKeySchema removes the schema of a key, its values are then free-form. Admin only.
+	DeleteKeySchema(context.Context, *DeleteKeySchemaRequest) (*emptypb.Empty, error)
+	// ListKeySchemas retrieves the schemas registered in the active project.
+	ListKeySchemas(context.Context, *ListKeySchemasRequest) (*ListKeySchemasResponse, error)
+	// GetValidationProfile retrieves the validation profile of the active project.
+	GetValidationProfile(context.Context, *GetValidationProfileRequest) (*ValidationProfileResponse, error)
+	// SetValidationProfile selects the validation profile of the active project, provided the stored metadata satisfies it. Admin only.
+	SetValidationProfile(context.Context, *SetValidationProfileRequest) (*ValidationProfileResponse, error)
 	// GetMetadata retrieves the most recently udpates set.
-	GetMetadata(context.Context, *emptypb.Empty) (*MetadataResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*MetadataResponse, error)
+	// GetKey retrieves the values of a single key of the active project.
+	GetKey(context.Context, *GetKeyRequest) (*GetKeyResponse, error)
+	// ListKeys retrieves the keys of the active project with their number of values, without the values.
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// SearchMetadata returns the keys and values of the active project matching a partial key or value, best matches first.
+	SearchMetadata(context.Context, *SearchMetadataRequest) (*SearchMetadataResponse, error)
+	// WatchMetadata streams the changes of the metadata of the active project.
+	WatchMetadata(*WatchMetadataRequest, MetadataService_WatchMetadataServer) error
+	// ListAuditEvents returns the audit records of the changes to the metadata of the active project, most recent first. Admin only.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 }
 
 // UnimplementedMetadataServiceServer should be embedded to have forward compatible implementations.
@@ -103,12 +308,54 @@ func (UnimplementedMetadataServiceServer) CreateOrUpdateMetadata(context.Context
 func (UnimplementedMetadataServiceServer) Delete(context.Context, *Metadata) (*MetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedMetadataServiceServer) GetMetadata(context.Context, *emptypb.Empty) (*MetadataResponse, error) {
+func (UnimplementedMetadataServiceServer) DeleteKey(context.Context, *DeleteKeyRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKey not implemented")
+}
+func (UnimplementedMetadataServiceServer) BatchDelete(context.Context, *MetadataList) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedMetadataServiceServer) RenameKey(context.Context, *RenameKeyRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameKey not implemented")
+}
+func (UnimplementedMetadataServiceServer) MergeValues(context.Context, *MergeValuesRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeValues not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetKeySchema(context.Context, *KeySchema) (*KeySchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeySchema not implemented")
+}
+func (UnimplementedMetadataServiceServer) DeleteKeySchema(context.Context, *DeleteKeySchemaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeySchema not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListKeySchemas(context.Context, *ListKeySchemasRequest) (*ListKeySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeySchemas not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetValidationProfile(context.Context, *GetValidationProfileRequest) (*ValidationProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationProfile not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetValidationProfile(context.Context, *SetValidationProfileRequest) (*ValidationProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidationProfile not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*MetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
 }
+func (UnimplementedMetadataServiceServer) GetKey(context.Context, *GetKeyRequest) (*GetKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKey not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
 func (UnimplementedMetadataServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedMetadataServiceServer) SearchMetadata(context.Context, *SearchMetadataRequest) (*SearchMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) WatchMetadata(*WatchMetadataRequest, MetadataService_WatchMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 
 // UnsafeMetadataServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MetadataServiceServer will
@@ -157,8 +404,170 @@ func _MetadataService_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetadataService_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/DeleteKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteKey(ctx, req.(*DeleteKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).BatchDelete(ctx, req.(*MetadataList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RenameKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RenameKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/RenameKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RenameKey(ctx, req.(*RenameKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_MergeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).MergeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/MergeValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).MergeValues(ctx, req.(*MergeValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetKeySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeySchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetKeySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/SetKeySchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetKeySchema(ctx, req.(*KeySchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DeleteKeySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteKeySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/DeleteKeySchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteKeySchema(ctx, req.(*DeleteKeySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListKeySchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeySchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListKeySchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/ListKeySchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListKeySchemas(ctx, req.(*ListKeySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetValidationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetValidationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/GetValidationProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetValidationProfile(ctx, req.(*GetValidationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetValidationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetValidationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetValidationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/SetValidationProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetValidationProfile(ctx, req.(*SetValidationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -170,7 +579,43 @@ func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/v1.MetadataService/GetMetadata",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*emptypb.Empty))
+		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/GetKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetKey(ctx, req.(*GetKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListKeys(ctx, req.(*ListKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -193,6 +638,63 @@ func _MetadataService_DeleteProject_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetadataService_SearchMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SearchMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/SearchMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SearchMetadata(ctx, req.(*SearchMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_WatchMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).WatchMetadata(m, &metadataServiceWatchMetadataServer{stream})
+}
+
+type MetadataService_WatchMetadataServer interface {
+	Send(*WatchMetadataResponse) error
+	grpc.ServerStream
+}
+
+type metadataServiceWatchMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataServiceWatchMetadataServer) Send(m *WatchMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetadataService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MetadataService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MetadataService_ServiceDesc is the grpc.ServiceDesc for MetadataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,15 +710,73 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MetadataService_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _MetadataService_DeleteKey_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _MetadataService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "RenameKey",
+			Handler:    _MetadataService_RenameKey_Handler,
+		},
+		{
+			MethodName: "MergeValues",
+			Handler:    _MetadataService_MergeValues_Handler,
+		},
+		{
+			MethodName: "SetKeySchema",
+			Handler:    _MetadataService_SetKeySchema_Handler,
+		},
+		{
+			MethodName: "DeleteKeySchema",
+			Handler:    _MetadataService_DeleteKeySchema_Handler,
+		},
+		{
+			MethodName: "ListKeySchemas",
+			Handler:    _MetadataService_ListKeySchemas_Handler,
+		},
+		{
+			MethodName: "GetValidationProfile",
+			Handler:    _MetadataService_GetValidationProfile_Handler,
+		},
+		{
+			MethodName: "SetValidationProfile",
+			Handler:    _MetadataService_SetValidationProfile_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _MetadataService_GetMetadata_Handler,
 		},
+		{
+			MethodName: "GetKey",
+			Handler:    _MetadataService_GetKey_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _MetadataService_ListKeys_Handler,
+		},
 		{
 			MethodName: "DeleteProject",
 			Handler:    _MetadataService_DeleteProject_Handler,
 		},
+		{
+			MethodName: "SearchMetadata",
+			Handler:    _MetadataService_SearchMetadata_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _MetadataService_ListAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMetadata",
+			Handler:       _MetadataService_WatchMetadata_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/service.proto",
 }
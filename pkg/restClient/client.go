@@ -97,12 +97,61 @@ type ClientInterface interface {
 	MetadataServiceDelete(ctx context.Context, params *MetadataServiceDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MetadataServiceGetMetadata request
-	MetadataServiceGetMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	MetadataServiceGetMetadata(ctx context.Context, params *MetadataServiceGetMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MetadataServiceCreateOrUpdateMetadata request with any body
-	MetadataServiceCreateOrUpdateMetadataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	MetadataServiceCreateOrUpdateMetadataWithBody(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	MetadataServiceCreateOrUpdateMetadata(ctx context.Context, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	MetadataServiceCreateOrUpdateMetadata(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceListAuditEvents request
+	MetadataServiceListAuditEvents(ctx context.Context, params *MetadataServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceBatchDelete request with any body
+	MetadataServiceBatchDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MetadataServiceBatchDelete(ctx context.Context, body MetadataServiceBatchDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceListKeys request
+	MetadataServiceListKeys(ctx context.Context, params *MetadataServiceListKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceDeleteKey request
+	MetadataServiceDeleteKey(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceGetKey request
+	MetadataServiceGetKey(ctx context.Context, key string, params *MetadataServiceGetKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceMergeValues request with any body
+	MetadataServiceMergeValuesWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MetadataServiceMergeValues(ctx context.Context, key string, body MetadataServiceMergeValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceRenameKey request with any body
+	MetadataServiceRenameKeyWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MetadataServiceRenameKey(ctx context.Context, key string, body MetadataServiceRenameKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceDeleteKeySchema request
+	MetadataServiceDeleteKeySchema(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceSetKeySchema request with any body
+	MetadataServiceSetKeySchemaWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MetadataServiceSetKeySchema(ctx context.Context, key string, body MetadataServiceSetKeySchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceGetValidationProfile request
+	MetadataServiceGetValidationProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceSetValidationProfile request with any body
+	MetadataServiceSetValidationProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MetadataServiceSetValidationProfile(ctx context.Context, body MetadataServiceSetValidationProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceListKeySchemas request
+	MetadataServiceListKeySchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetadataServiceSearchMetadata request
+	MetadataServiceSearchMetadata(ctx context.Context, params *MetadataServiceSearchMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MetadataServiceDeleteProject request
 	MetadataServiceDeleteProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -120,8 +169,140 @@ func (c *Client) MetadataServiceDelete(ctx context.Context, params *MetadataServ
 	return c.Client.Do(req)
 }
 
-func (c *Client) MetadataServiceGetMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMetadataServiceGetMetadataRequest(c.Server)
+func (c *Client) MetadataServiceGetMetadata(ctx context.Context, params *MetadataServiceGetMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceGetMetadataRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceCreateOrUpdateMetadataWithBody(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceCreateOrUpdateMetadata(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceCreateOrUpdateMetadataRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceListAuditEvents(ctx context.Context, params *MetadataServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceListAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceBatchDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceBatchDeleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceBatchDelete(ctx context.Context, body MetadataServiceBatchDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceBatchDeleteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceListKeys(ctx context.Context, params *MetadataServiceListKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceListKeysRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceDeleteKey(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceDeleteKeyRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceGetKey(ctx context.Context, key string, params *MetadataServiceGetKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceGetKeyRequest(c.Server, key, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceMergeValuesWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceMergeValuesRequestWithBody(c.Server, key, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceMergeValues(ctx context.Context, key string, body MetadataServiceMergeValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceMergeValuesRequest(c.Server, key, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceRenameKeyWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceRenameKeyRequestWithBody(c.Server, key, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -132,8 +313,8 @@ func (c *Client) MetadataServiceGetMetadata(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) MetadataServiceCreateOrUpdateMetadataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(c.Server, contentType, body)
+func (c *Client) MetadataServiceRenameKey(ctx context.Context, key string, body MetadataServiceRenameKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceRenameKeyRequest(c.Server, key, body)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +325,92 @@ func (c *Client) MetadataServiceCreateOrUpdateMetadataWithBody(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) MetadataServiceCreateOrUpdateMetadata(ctx context.Context, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMetadataServiceCreateOrUpdateMetadataRequest(c.Server, body)
+func (c *Client) MetadataServiceDeleteKeySchema(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceDeleteKeySchemaRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceSetKeySchemaWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceSetKeySchemaRequestWithBody(c.Server, key, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceSetKeySchema(ctx context.Context, key string, body MetadataServiceSetKeySchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceSetKeySchemaRequest(c.Server, key, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceGetValidationProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceGetValidationProfileRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceSetValidationProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceSetValidationProfileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceSetValidationProfile(ctx context.Context, body MetadataServiceSetValidationProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceSetValidationProfileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceListKeySchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceListKeySchemasRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MetadataServiceSearchMetadata(ctx context.Context, params *MetadataServiceSearchMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataServiceSearchMetadataRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +486,38 @@ func NewMetadataServiceDeleteRequest(server string, params *MetadataServiceDelet
 
 	}
 
+	if params.Owner != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
@@ -232,7 +529,7 @@ func NewMetadataServiceDeleteRequest(server string, params *MetadataServiceDelet
 }
 
 // NewMetadataServiceGetMetadataRequest generates requests for MetadataServiceGetMetadata
-func NewMetadataServiceGetMetadataRequest(server string) (*http.Request, error) {
+func NewMetadataServiceGetMetadataRequest(server string, params *MetadataServiceGetMetadataParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -250,32 +547,116 @@ func NewMetadataServiceGetMetadataRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	queryValues := queryURL.Query()
 
-	return req, nil
-}
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// NewMetadataServiceCreateOrUpdateMetadataRequest calls the generic MetadataServiceCreateOrUpdateMetadata builder with application/json body
-func NewMetadataServiceCreateOrUpdateMetadataRequest(server string, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewMetadataServiceCreateOrUpdateMetadataRequestWithBody generates requests for MetadataServiceCreateOrUpdateMetadata with any type of body
-func NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params.PageSize != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.PageToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Keys != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceCreateOrUpdateMetadataRequest calls the generic MetadataServiceCreateOrUpdateMetadata builder with application/json body
+func NewMetadataServiceCreateOrUpdateMetadataRequest(server string, params *MetadataServiceCreateOrUpdateMetadataParams, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewMetadataServiceCreateOrUpdateMetadataRequestWithBody generates requests for MetadataServiceCreateOrUpdateMetadata with any type of body
+func NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server string, params *MetadataServiceCreateOrUpdateMetadataParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
 	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata")
@@ -288,6 +669,26 @@ func NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server string, cont
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.ExpectedRevision != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expectedRevision", runtime.ParamLocationQuery, *params.ExpectedRevision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -298,245 +699,1778 @@ func NewMetadataServiceCreateOrUpdateMetadataRequestWithBody(server string, cont
 	return req, nil
 }
 
-// NewMetadataServiceDeleteProjectRequest generates requests for MetadataServiceDeleteProject
-func NewMetadataServiceDeleteProjectRequest(server string, id string) (*http.Request, error) {
+// NewMetadataServiceListAuditEventsRequest generates requests for MetadataServiceListAuditEvents
+func NewMetadataServiceListAuditEventsRequest(server string, params *MetadataServiceListAuditEventsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Key != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Rpc != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rpc", runtime.ParamLocationQuery, *params.Rpc); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.PageSize != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.PageToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceBatchDeleteRequest calls the generic MetadataServiceBatchDelete builder with application/json body
+func NewMetadataServiceBatchDeleteRequest(server string, body MetadataServiceBatchDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceBatchDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMetadataServiceBatchDeleteRequestWithBody generates requests for MetadataServiceBatchDelete with any type of body
+func NewMetadataServiceBatchDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/batchDelete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMetadataServiceListKeysRequest generates requests for MetadataServiceListKeys
+func NewMetadataServiceListKeysRequest(server string, params *MetadataServiceListKeysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceDeleteKeyRequest generates requests for MetadataServiceDeleteKey
+func NewMetadataServiceDeleteKeyRequest(server string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceGetKeyRequest generates requests for MetadataServiceGetKey
+func NewMetadataServiceGetKeyRequest(server string, key string, params *MetadataServiceGetKeyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceMergeValuesRequest calls the generic MetadataServiceMergeValues builder with application/json body
+func NewMetadataServiceMergeValuesRequest(server string, key string, body MetadataServiceMergeValuesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceMergeValuesRequestWithBody(server, key, "application/json", bodyReader)
+}
+
+// NewMetadataServiceMergeValuesRequestWithBody generates requests for MetadataServiceMergeValues with any type of body
+func NewMetadataServiceMergeValuesRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMetadataServiceRenameKeyRequest calls the generic MetadataServiceRenameKey builder with application/json body
+func NewMetadataServiceRenameKeyRequest(server string, key string, body MetadataServiceRenameKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceRenameKeyRequestWithBody(server, key, "application/json", bodyReader)
+}
+
+// NewMetadataServiceRenameKeyRequestWithBody generates requests for MetadataServiceRenameKey with any type of body
+func NewMetadataServiceRenameKeyRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s/rename", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMetadataServiceDeleteKeySchemaRequest generates requests for MetadataServiceDeleteKeySchema
+func NewMetadataServiceDeleteKeySchemaRequest(server string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s/schema", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceSetKeySchemaRequest calls the generic MetadataServiceSetKeySchema builder with application/json body
+func NewMetadataServiceSetKeySchemaRequest(server string, key string, body MetadataServiceSetKeySchemaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceSetKeySchemaRequestWithBody(server, key, "application/json", bodyReader)
+}
+
+// NewMetadataServiceSetKeySchemaRequestWithBody generates requests for MetadataServiceSetKeySchema with any type of body
+func NewMetadataServiceSetKeySchemaRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/keys/%s/schema", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMetadataServiceGetValidationProfileRequest generates requests for MetadataServiceGetValidationProfile
+func NewMetadataServiceGetValidationProfileRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceSetValidationProfileRequest calls the generic MetadataServiceSetValidationProfile builder with application/json body
+func NewMetadataServiceSetValidationProfileRequest(server string, body MetadataServiceSetValidationProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMetadataServiceSetValidationProfileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMetadataServiceSetValidationProfileRequestWithBody generates requests for MetadataServiceSetValidationProfile with any type of body
+func NewMetadataServiceSetValidationProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMetadataServiceListKeySchemasRequest generates requests for MetadataServiceListKeySchemas
+func NewMetadataServiceListKeySchemasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/schemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceSearchMetadataRequest generates requests for MetadataServiceSearchMetadata
+func NewMetadataServiceSearchMetadataRequest(server string, params *MetadataServiceSearchMetadataParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/metadata/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Query != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Key != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Mode != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetadataServiceDeleteProjectRequest generates requests for MetadataServiceDeleteProject
+func NewMetadataServiceDeleteProjectRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/project/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// MetadataServiceDelete request
+	MetadataServiceDeleteWithResponse(ctx context.Context, params *MetadataServiceDeleteParams, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteResponse, error)
+
+	// MetadataServiceGetMetadata request
+	MetadataServiceGetMetadataWithResponse(ctx context.Context, params *MetadataServiceGetMetadataParams, reqEditors ...RequestEditorFn) (*MetadataServiceGetMetadataResponse, error)
+
+	// MetadataServiceCreateOrUpdateMetadata request with any body
+	MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error)
+
+	MetadataServiceCreateOrUpdateMetadataWithResponse(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error)
+
+	// MetadataServiceListAuditEvents request
+	MetadataServiceListAuditEventsWithResponse(ctx context.Context, params *MetadataServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*MetadataServiceListAuditEventsResponse, error)
+
+	// MetadataServiceBatchDelete request with any body
+	MetadataServiceBatchDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceBatchDeleteResponse, error)
+
+	MetadataServiceBatchDeleteWithResponse(ctx context.Context, body MetadataServiceBatchDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceBatchDeleteResponse, error)
+
+	// MetadataServiceListKeys request
+	MetadataServiceListKeysWithResponse(ctx context.Context, params *MetadataServiceListKeysParams, reqEditors ...RequestEditorFn) (*MetadataServiceListKeysResponse, error)
+
+	// MetadataServiceDeleteKey request
+	MetadataServiceDeleteKeyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteKeyResponse, error)
+
+	// MetadataServiceGetKey request
+	MetadataServiceGetKeyWithResponse(ctx context.Context, key string, params *MetadataServiceGetKeyParams, reqEditors ...RequestEditorFn) (*MetadataServiceGetKeyResponse, error)
+
+	// MetadataServiceMergeValues request with any body
+	MetadataServiceMergeValuesWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceMergeValuesResponse, error)
+
+	MetadataServiceMergeValuesWithResponse(ctx context.Context, key string, body MetadataServiceMergeValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceMergeValuesResponse, error)
+
+	// MetadataServiceRenameKey request with any body
+	MetadataServiceRenameKeyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceRenameKeyResponse, error)
+
+	MetadataServiceRenameKeyWithResponse(ctx context.Context, key string, body MetadataServiceRenameKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceRenameKeyResponse, error)
+
+	// MetadataServiceDeleteKeySchema request
+	MetadataServiceDeleteKeySchemaWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteKeySchemaResponse, error)
+
+	// MetadataServiceSetKeySchema request with any body
+	MetadataServiceSetKeySchemaWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceSetKeySchemaResponse, error)
+
+	MetadataServiceSetKeySchemaWithResponse(ctx context.Context, key string, body MetadataServiceSetKeySchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceSetKeySchemaResponse, error)
+
+	// MetadataServiceGetValidationProfile request
+	MetadataServiceGetValidationProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceGetValidationProfileResponse, error)
+
+	// MetadataServiceSetValidationProfile request with any body
+	MetadataServiceSetValidationProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceSetValidationProfileResponse, error)
+
+	MetadataServiceSetValidationProfileWithResponse(ctx context.Context, body MetadataServiceSetValidationProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceSetValidationProfileResponse, error)
+
+	// MetadataServiceListKeySchemas request
+	MetadataServiceListKeySchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceListKeySchemasResponse, error)
+
+	// MetadataServiceSearchMetadata request
+	MetadataServiceSearchMetadataWithResponse(ctx context.Context, params *MetadataServiceSearchMetadataParams, reqEditors ...RequestEditorFn) (*MetadataServiceSearchMetadataResponse, error)
+
+	// MetadataServiceDeleteProject request
+	MetadataServiceDeleteProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteProjectResponse, error)
+}
+
+type MetadataServiceDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceGetMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceGetMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceGetMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceCreateOrUpdateMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceCreateOrUpdateMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceCreateOrUpdateMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceListAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAuditEventsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceListAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceListAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceBatchDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceBatchDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceBatchDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceListKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListKeysResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceListKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceListKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceDeleteKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceDeleteKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceDeleteKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceGetKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetKeyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceGetKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceGetKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceMergeValuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceMergeValuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceMergeValuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceRenameKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceRenameKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceRenameKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceDeleteKeySchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceDeleteKeySchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceDeleteKeySchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceSetKeySchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KeySchema
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceSetKeySchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceSetKeySchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceGetValidationProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidationProfileResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceGetValidationProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceGetValidationProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceSetValidationProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidationProfileResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceSetValidationProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceSetValidationProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceListKeySchemasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListKeySchemasResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceListKeySchemasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceListKeySchemasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceSearchMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchMetadataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceSearchMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceSearchMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataServiceDeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataServiceDeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataServiceDeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// MetadataServiceDeleteWithResponse request returning *MetadataServiceDeleteResponse
+func (c *ClientWithResponses) MetadataServiceDeleteWithResponse(ctx context.Context, params *MetadataServiceDeleteParams, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteResponse, error) {
+	rsp, err := c.MetadataServiceDelete(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceDeleteResponse(rsp)
+}
+
+// MetadataServiceGetMetadataWithResponse request returning *MetadataServiceGetMetadataResponse
+func (c *ClientWithResponses) MetadataServiceGetMetadataWithResponse(ctx context.Context, params *MetadataServiceGetMetadataParams, reqEditors ...RequestEditorFn) (*MetadataServiceGetMetadataResponse, error) {
+	rsp, err := c.MetadataServiceGetMetadata(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceGetMetadataResponse(rsp)
+}
+
+// MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse request with arbitrary body returning *MetadataServiceCreateOrUpdateMetadataResponse
+func (c *ClientWithResponses) MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
+	rsp, err := c.MetadataServiceCreateOrUpdateMetadataWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceCreateOrUpdateMetadataWithResponse(ctx context.Context, params *MetadataServiceCreateOrUpdateMetadataParams, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
+	rsp, err := c.MetadataServiceCreateOrUpdateMetadata(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp)
+}
+
+// MetadataServiceListAuditEventsWithResponse request returning *MetadataServiceListAuditEventsResponse
+func (c *ClientWithResponses) MetadataServiceListAuditEventsWithResponse(ctx context.Context, params *MetadataServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*MetadataServiceListAuditEventsResponse, error) {
+	rsp, err := c.MetadataServiceListAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceListAuditEventsResponse(rsp)
+}
+
+// MetadataServiceBatchDeleteWithBodyWithResponse request with arbitrary body returning *MetadataServiceBatchDeleteResponse
+func (c *ClientWithResponses) MetadataServiceBatchDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceBatchDeleteResponse, error) {
+	rsp, err := c.MetadataServiceBatchDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceBatchDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceBatchDeleteWithResponse(ctx context.Context, body MetadataServiceBatchDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceBatchDeleteResponse, error) {
+	rsp, err := c.MetadataServiceBatchDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceBatchDeleteResponse(rsp)
+}
+
+// MetadataServiceListKeysWithResponse request returning *MetadataServiceListKeysResponse
+func (c *ClientWithResponses) MetadataServiceListKeysWithResponse(ctx context.Context, params *MetadataServiceListKeysParams, reqEditors ...RequestEditorFn) (*MetadataServiceListKeysResponse, error) {
+	rsp, err := c.MetadataServiceListKeys(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceListKeysResponse(rsp)
+}
+
+// MetadataServiceDeleteKeyWithResponse request returning *MetadataServiceDeleteKeyResponse
+func (c *ClientWithResponses) MetadataServiceDeleteKeyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteKeyResponse, error) {
+	rsp, err := c.MetadataServiceDeleteKey(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceDeleteKeyResponse(rsp)
+}
+
+// MetadataServiceGetKeyWithResponse request returning *MetadataServiceGetKeyResponse
+func (c *ClientWithResponses) MetadataServiceGetKeyWithResponse(ctx context.Context, key string, params *MetadataServiceGetKeyParams, reqEditors ...RequestEditorFn) (*MetadataServiceGetKeyResponse, error) {
+	rsp, err := c.MetadataServiceGetKey(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceGetKeyResponse(rsp)
+}
+
+// MetadataServiceMergeValuesWithBodyWithResponse request with arbitrary body returning *MetadataServiceMergeValuesResponse
+func (c *ClientWithResponses) MetadataServiceMergeValuesWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceMergeValuesResponse, error) {
+	rsp, err := c.MetadataServiceMergeValuesWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceMergeValuesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceMergeValuesWithResponse(ctx context.Context, key string, body MetadataServiceMergeValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceMergeValuesResponse, error) {
+	rsp, err := c.MetadataServiceMergeValues(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceMergeValuesResponse(rsp)
+}
+
+// MetadataServiceRenameKeyWithBodyWithResponse request with arbitrary body returning *MetadataServiceRenameKeyResponse
+func (c *ClientWithResponses) MetadataServiceRenameKeyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceRenameKeyResponse, error) {
+	rsp, err := c.MetadataServiceRenameKeyWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceRenameKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceRenameKeyWithResponse(ctx context.Context, key string, body MetadataServiceRenameKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceRenameKeyResponse, error) {
+	rsp, err := c.MetadataServiceRenameKey(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceRenameKeyResponse(rsp)
+}
+
+// MetadataServiceDeleteKeySchemaWithResponse request returning *MetadataServiceDeleteKeySchemaResponse
+func (c *ClientWithResponses) MetadataServiceDeleteKeySchemaWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteKeySchemaResponse, error) {
+	rsp, err := c.MetadataServiceDeleteKeySchema(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceDeleteKeySchemaResponse(rsp)
+}
+
+// MetadataServiceSetKeySchemaWithBodyWithResponse request with arbitrary body returning *MetadataServiceSetKeySchemaResponse
+func (c *ClientWithResponses) MetadataServiceSetKeySchemaWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceSetKeySchemaResponse, error) {
+	rsp, err := c.MetadataServiceSetKeySchemaWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceSetKeySchemaResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceSetKeySchemaWithResponse(ctx context.Context, key string, body MetadataServiceSetKeySchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceSetKeySchemaResponse, error) {
+	rsp, err := c.MetadataServiceSetKeySchema(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceSetKeySchemaResponse(rsp)
+}
+
+// MetadataServiceGetValidationProfileWithResponse request returning *MetadataServiceGetValidationProfileResponse
+func (c *ClientWithResponses) MetadataServiceGetValidationProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceGetValidationProfileResponse, error) {
+	rsp, err := c.MetadataServiceGetValidationProfile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceGetValidationProfileResponse(rsp)
+}
+
+// MetadataServiceSetValidationProfileWithBodyWithResponse request with arbitrary body returning *MetadataServiceSetValidationProfileResponse
+func (c *ClientWithResponses) MetadataServiceSetValidationProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceSetValidationProfileResponse, error) {
+	rsp, err := c.MetadataServiceSetValidationProfileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceSetValidationProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) MetadataServiceSetValidationProfileWithResponse(ctx context.Context, body MetadataServiceSetValidationProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceSetValidationProfileResponse, error) {
+	rsp, err := c.MetadataServiceSetValidationProfile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceSetValidationProfileResponse(rsp)
+}
+
+// MetadataServiceListKeySchemasWithResponse request returning *MetadataServiceListKeySchemasResponse
+func (c *ClientWithResponses) MetadataServiceListKeySchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceListKeySchemasResponse, error) {
+	rsp, err := c.MetadataServiceListKeySchemas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceListKeySchemasResponse(rsp)
+}
+
+// MetadataServiceSearchMetadataWithResponse request returning *MetadataServiceSearchMetadataResponse
+func (c *ClientWithResponses) MetadataServiceSearchMetadataWithResponse(ctx context.Context, params *MetadataServiceSearchMetadataParams, reqEditors ...RequestEditorFn) (*MetadataServiceSearchMetadataResponse, error) {
+	rsp, err := c.MetadataServiceSearchMetadata(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceSearchMetadataResponse(rsp)
+}
+
+// MetadataServiceDeleteProjectWithResponse request returning *MetadataServiceDeleteProjectResponse
+func (c *ClientWithResponses) MetadataServiceDeleteProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteProjectResponse, error) {
+	rsp, err := c.MetadataServiceDeleteProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataServiceDeleteProjectResponse(rsp)
+}
+
+// ParseMetadataServiceDeleteResponse parses an HTTP response from a MetadataServiceDeleteWithResponse call
+func ParseMetadataServiceDeleteResponse(rsp *http.Response) (*MetadataServiceDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetadataServiceDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetadataServiceGetMetadataResponse parses an HTTP response from a MetadataServiceGetMetadataWithResponse call
+func ParseMetadataServiceGetMetadataResponse(rsp *http.Response) (*MetadataServiceGetMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetadataServiceGetMetadataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetadataServiceCreateOrUpdateMetadataResponse parses an HTTP response from a MetadataServiceCreateOrUpdateMetadataWithResponse call
+func ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp *http.Response) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetadataServiceCreateOrUpdateMetadataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetadataServiceListAuditEventsResponse parses an HTTP response from a MetadataServiceListAuditEventsWithResponse call
+func ParseMetadataServiceListAuditEventsResponse(rsp *http.Response) (*MetadataServiceListAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetadataServiceListAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAuditEventsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetadataServiceBatchDeleteResponse parses an HTTP response from a MetadataServiceBatchDeleteWithResponse call
+func ParseMetadataServiceBatchDeleteResponse(rsp *http.Response) (*MetadataServiceBatchDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetadataServiceBatchDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetadataServiceListKeysResponse parses an HTTP response from a MetadataServiceListKeysWithResponse call
+func ParseMetadataServiceListKeysResponse(rsp *http.Response) (*MetadataServiceListKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metadata.orchestrator.apis/v1/project/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &MetadataServiceListKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListKeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseMetadataServiceDeleteKeyResponse parses an HTTP response from a MetadataServiceDeleteKeyWithResponse call
+func ParseMetadataServiceDeleteKeyResponse(rsp *http.Response) (*MetadataServiceDeleteKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	response := &MetadataServiceDeleteKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseMetadataServiceGetKeyResponse parses an HTTP response from a MetadataServiceGetKeyWithResponse call
+func ParseMetadataServiceGetKeyResponse(rsp *http.Response) (*MetadataServiceGetKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &MetadataServiceGetKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// MetadataServiceDelete request
-	MetadataServiceDeleteWithResponse(ctx context.Context, params *MetadataServiceDeleteParams, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteResponse, error)
-
-	// MetadataServiceGetMetadata request
-	MetadataServiceGetMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceGetMetadataResponse, error)
-
-	// MetadataServiceCreateOrUpdateMetadata request with any body
-	MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error)
 
-	MetadataServiceCreateOrUpdateMetadataWithResponse(ctx context.Context, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// MetadataServiceDeleteProject request
-	MetadataServiceDeleteProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteProjectResponse, error)
-}
+	}
 
-type MetadataServiceDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetadataResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MetadataServiceDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseMetadataServiceMergeValuesResponse parses an HTTP response from a MetadataServiceMergeValuesWithResponse call
+func ParseMetadataServiceMergeValuesResponse(rsp *http.Response) (*MetadataServiceMergeValuesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MetadataServiceDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &MetadataServiceMergeValuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type MetadataServiceGetMetadataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetadataResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r MetadataServiceGetMetadataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MetadataServiceGetMetadataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type MetadataServiceCreateOrUpdateMetadataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetadataResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MetadataServiceCreateOrUpdateMetadataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseMetadataServiceRenameKeyResponse parses an HTTP response from a MetadataServiceRenameKeyWithResponse call
+func ParseMetadataServiceRenameKeyResponse(rsp *http.Response) (*MetadataServiceRenameKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MetadataServiceCreateOrUpdateMetadataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &MetadataServiceRenameKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type MetadataServiceDeleteProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r MetadataServiceDeleteProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MetadataServiceDeleteProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// MetadataServiceDeleteWithResponse request returning *MetadataServiceDeleteResponse
-func (c *ClientWithResponses) MetadataServiceDeleteWithResponse(ctx context.Context, params *MetadataServiceDeleteParams, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteResponse, error) {
-	rsp, err := c.MetadataServiceDelete(ctx, params, reqEditors...)
+// ParseMetadataServiceDeleteKeySchemaResponse parses an HTTP response from a MetadataServiceDeleteKeySchemaWithResponse call
+func ParseMetadataServiceDeleteKeySchemaResponse(rsp *http.Response) (*MetadataServiceDeleteKeySchemaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataServiceDeleteResponse(rsp)
-}
 
-// MetadataServiceGetMetadataWithResponse request returning *MetadataServiceGetMetadataResponse
-func (c *ClientWithResponses) MetadataServiceGetMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataServiceGetMetadataResponse, error) {
-	rsp, err := c.MetadataServiceGetMetadata(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataServiceDeleteKeySchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMetadataServiceGetMetadataResponse(rsp)
+
+	return response, nil
 }
 
-// MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse request with arbitrary body returning *MetadataServiceCreateOrUpdateMetadataResponse
-func (c *ClientWithResponses) MetadataServiceCreateOrUpdateMetadataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
-	rsp, err := c.MetadataServiceCreateOrUpdateMetadataWithBody(ctx, contentType, body, reqEditors...)
+// ParseMetadataServiceSetKeySchemaResponse parses an HTTP response from a MetadataServiceSetKeySchemaWithResponse call
+func ParseMetadataServiceSetKeySchemaResponse(rsp *http.Response) (*MetadataServiceSetKeySchemaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp)
-}
 
-func (c *ClientWithResponses) MetadataServiceCreateOrUpdateMetadataWithResponse(ctx context.Context, body MetadataServiceCreateOrUpdateMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
-	rsp, err := c.MetadataServiceCreateOrUpdateMetadata(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataServiceSetKeySchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KeySchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// MetadataServiceDeleteProjectWithResponse request returning *MetadataServiceDeleteProjectResponse
-func (c *ClientWithResponses) MetadataServiceDeleteProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*MetadataServiceDeleteProjectResponse, error) {
-	rsp, err := c.MetadataServiceDeleteProject(ctx, id, reqEditors...)
+// ParseMetadataServiceGetValidationProfileResponse parses an HTTP response from a MetadataServiceGetValidationProfileWithResponse call
+func ParseMetadataServiceGetValidationProfileResponse(rsp *http.Response) (*MetadataServiceGetValidationProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataServiceDeleteProjectResponse(rsp)
+
+	response := &MetadataServiceGetValidationProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidationProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseMetadataServiceDeleteResponse parses an HTTP response from a MetadataServiceDeleteWithResponse call
-func ParseMetadataServiceDeleteResponse(rsp *http.Response) (*MetadataServiceDeleteResponse, error) {
+// ParseMetadataServiceSetValidationProfileResponse parses an HTTP response from a MetadataServiceSetValidationProfileWithResponse call
+func ParseMetadataServiceSetValidationProfileResponse(rsp *http.Response) (*MetadataServiceSetValidationProfileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataServiceDeleteResponse{
+	response := &MetadataServiceSetValidationProfileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetadataResponse
+		var dest ValidationProfileResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -547,22 +2481,22 @@ func ParseMetadataServiceDeleteResponse(rsp *http.Response) (*MetadataServiceDel
 	return response, nil
 }
 
-// ParseMetadataServiceGetMetadataResponse parses an HTTP response from a MetadataServiceGetMetadataWithResponse call
-func ParseMetadataServiceGetMetadataResponse(rsp *http.Response) (*MetadataServiceGetMetadataResponse, error) {
+// ParseMetadataServiceListKeySchemasResponse parses an HTTP response from a MetadataServiceListKeySchemasWithResponse call
+func ParseMetadataServiceListKeySchemasResponse(rsp *http.Response) (*MetadataServiceListKeySchemasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataServiceGetMetadataResponse{
+	response := &MetadataServiceListKeySchemasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetadataResponse
+		var dest ListKeySchemasResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -573,22 +2507,22 @@ func ParseMetadataServiceGetMetadataResponse(rsp *http.Response) (*MetadataServi
 	return response, nil
 }
 
-// ParseMetadataServiceCreateOrUpdateMetadataResponse parses an HTTP response from a MetadataServiceCreateOrUpdateMetadataWithResponse call
-func ParseMetadataServiceCreateOrUpdateMetadataResponse(rsp *http.Response) (*MetadataServiceCreateOrUpdateMetadataResponse, error) {
+// ParseMetadataServiceSearchMetadataResponse parses an HTTP response from a MetadataServiceSearchMetadataWithResponse call
+func ParseMetadataServiceSearchMetadataResponse(rsp *http.Response) (*MetadataServiceSearchMetadataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataServiceCreateOrUpdateMetadataResponse{
+	response := &MetadataServiceSearchMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetadataResponse
+		var dest SearchMetadataResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7,10 +7,220 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.12.0 DO NOT EDIT.
 package restClient
 
+import (
+	"time"
+)
+
+// Defines values for AuditEventDecision.
+const (
+	DECISIONALLOWED     AuditEventDecision = "DECISION_ALLOWED"
+	DECISIONDENIED      AuditEventDecision = "DECISION_DENIED"
+	DECISIONDISABLED    AuditEventDecision = "DECISION_DISABLED"
+	DECISIONERROR       AuditEventDecision = "DECISION_ERROR"
+	DECISIONSYSTEM      AuditEventDecision = "DECISION_SYSTEM"
+	DECISIONUNSPECIFIED AuditEventDecision = "DECISION_UNSPECIFIED"
+)
+
+// Defines values for KeySchemaProfile.
+const (
+	KeySchemaProfileVALIDATIONPROFILEEXTENDED    KeySchemaProfile = "VALIDATION_PROFILE_EXTENDED"
+	KeySchemaProfileVALIDATIONPROFILEK8SLABEL    KeySchemaProfile = "VALIDATION_PROFILE_K8S_LABEL"
+	KeySchemaProfileVALIDATIONPROFILEUNSPECIFIED KeySchemaProfile = "VALIDATION_PROFILE_UNSPECIFIED"
+)
+
+// Defines values for KeySchemaType.
+const (
+	TYPEENUM        KeySchemaType = "TYPE_ENUM"
+	TYPEFREEFORM    KeySchemaType = "TYPE_FREE_FORM"
+	TYPEREGEX       KeySchemaType = "TYPE_REGEX"
+	TYPEUNSPECIFIED KeySchemaType = "TYPE_UNSPECIFIED"
+)
+
+// Defines values for SearchMatchType.
+const (
+	MATCHTYPEEXACT       SearchMatchType = "MATCH_TYPE_EXACT"
+	MATCHTYPEFUZZY       SearchMatchType = "MATCH_TYPE_FUZZY"
+	MATCHTYPEPREFIX      SearchMatchType = "MATCH_TYPE_PREFIX"
+	MATCHTYPESUBSTRING   SearchMatchType = "MATCH_TYPE_SUBSTRING"
+	MATCHTYPEUNSPECIFIED SearchMatchType = "MATCH_TYPE_UNSPECIFIED"
+)
+
+// Defines values for SetValidationProfileRequestProfile.
+const (
+	SetValidationProfileRequestProfileVALIDATIONPROFILEEXTENDED    SetValidationProfileRequestProfile = "VALIDATION_PROFILE_EXTENDED"
+	SetValidationProfileRequestProfileVALIDATIONPROFILEK8SLABEL    SetValidationProfileRequestProfile = "VALIDATION_PROFILE_K8S_LABEL"
+	SetValidationProfileRequestProfileVALIDATIONPROFILEUNSPECIFIED SetValidationProfileRequestProfile = "VALIDATION_PROFILE_UNSPECIFIED"
+)
+
+// Defines values for ValidationProfileResponseProfile.
+const (
+	VALIDATIONPROFILEEXTENDED    ValidationProfileResponseProfile = "VALIDATION_PROFILE_EXTENDED"
+	VALIDATIONPROFILEK8SLABEL    ValidationProfileResponseProfile = "VALIDATION_PROFILE_K8S_LABEL"
+	VALIDATIONPROFILEUNSPECIFIED ValidationProfileResponseProfile = "VALIDATION_PROFILE_UNSPECIFIED"
+)
+
+// Defines values for MetadataServiceGetMetadataParamsOrderBy.
+const (
+	MetadataServiceGetMetadataParamsOrderByORDERBYALPHABETICAL MetadataServiceGetMetadataParamsOrderBy = "ORDER_BY_ALPHABETICAL"
+	MetadataServiceGetMetadataParamsOrderByORDERBYPOPULARITY   MetadataServiceGetMetadataParamsOrderBy = "ORDER_BY_POPULARITY"
+	MetadataServiceGetMetadataParamsOrderByORDERBYRECENTLYUSED MetadataServiceGetMetadataParamsOrderBy = "ORDER_BY_RECENTLY_USED"
+	MetadataServiceGetMetadataParamsOrderByORDERBYUNSPECIFIED  MetadataServiceGetMetadataParamsOrderBy = "ORDER_BY_UNSPECIFIED"
+)
+
+// Defines values for MetadataServiceListKeysParamsOrderBy.
+const (
+	MetadataServiceListKeysParamsOrderByORDERBYALPHABETICAL MetadataServiceListKeysParamsOrderBy = "ORDER_BY_ALPHABETICAL"
+	MetadataServiceListKeysParamsOrderByORDERBYPOPULARITY   MetadataServiceListKeysParamsOrderBy = "ORDER_BY_POPULARITY"
+	MetadataServiceListKeysParamsOrderByORDERBYRECENTLYUSED MetadataServiceListKeysParamsOrderBy = "ORDER_BY_RECENTLY_USED"
+	MetadataServiceListKeysParamsOrderByORDERBYUNSPECIFIED  MetadataServiceListKeysParamsOrderBy = "ORDER_BY_UNSPECIFIED"
+)
+
+// Defines values for MetadataServiceGetKeyParamsOrderBy.
+const (
+	ORDERBYALPHABETICAL MetadataServiceGetKeyParamsOrderBy = "ORDER_BY_ALPHABETICAL"
+	ORDERBYPOPULARITY   MetadataServiceGetKeyParamsOrderBy = "ORDER_BY_POPULARITY"
+	ORDERBYRECENTLYUSED MetadataServiceGetKeyParamsOrderBy = "ORDER_BY_RECENTLY_USED"
+	ORDERBYUNSPECIFIED  MetadataServiceGetKeyParamsOrderBy = "ORDER_BY_UNSPECIFIED"
+)
+
+// Defines values for MetadataServiceSearchMetadataParamsMode.
+const (
+	SEARCHMODEFUZZY       MetadataServiceSearchMetadataParamsMode = "SEARCH_MODE_FUZZY"
+	SEARCHMODEPREFIX      MetadataServiceSearchMetadataParamsMode = "SEARCH_MODE_PREFIX"
+	SEARCHMODESUBSTRING   MetadataServiceSearchMetadataParamsMode = "SEARCH_MODE_SUBSTRING"
+	SEARCHMODEUNSPECIFIED MetadataServiceSearchMetadataParamsMode = "SEARCH_MODE_UNSPECIFIED"
+)
+
+// AuditChange AuditChange is the values of a key before and after a change.
+type AuditChange struct {
+	After        *[]string `json:"after,omitempty"`
+	Before       *[]string `json:"before,omitempty"`
+	DisplayAfter *string   `json:"displayAfter,omitempty"`
+
+	// DisplayBefore display_before and display_after are how the key was displayed before and after a change of its casing.
+	DisplayBefore *string `json:"displayBefore,omitempty"`
+	Key           string  `json:"key"`
+}
+
+// AuditEvent AuditEvent records a call changing the metadata of a project, whether it was allowed or not.
+type AuditEvent struct {
+	// Changes changes are the values of the keys changed by the call.
+	Changes *[]AuditChange `json:"changes,omitempty"`
+
+	// Client client is the client metadata of the call, e.g. metadata-cli.
+	Client   *string            `json:"client,omitempty"`
+	Decision AuditEventDecision `json:"decision"`
+
+	// Error error is why an allowed call failed, empty when it succeeded.
+	Error *string `json:"error,omitempty"`
+
+	// Hash hash is the SHA-256 of the event, including previous_hash.
+	Hash string `json:"hash"`
+
+	// PreviousHash previous_hash is the hash of the previous event of the audit log, chaining the events.
+	PreviousHash string `json:"previousHash"`
+	ProjectId    string `json:"projectId"`
+
+	// RequestId request_id is the x-request-id of the call.
+	RequestId *string `json:"requestId,omitempty"`
+	Rpc       string  `json:"rpc"`
+
+	// Sequence sequence numbers the events of the audit log.
+	Sequence string `json:"sequence"`
+
+	// Subject subject is the sub claim of the token of the caller, username its preferred_username claim.
+	Subject  *string   `json:"subject,omitempty"`
+	Time     time.Time `json:"time"`
+	Username *string   `json:"username,omitempty"`
+}
+
+// AuditEventDecision defines model for AuditEvent.Decision.
+type AuditEventDecision string
+
+// GetKeyResponse defines model for GetKeyResponse.
+type GetKeyResponse struct {
+	// Metadata StoredMetadata represents all stored metadata values for a given key, in their normalized (lowercase) form.
+	Metadata StoredMetadata `json:"metadata"`
+
+	// Revision revision of the project metadata, also returned as the ETag header.
+	Revision *string `json:"revision,omitempty"`
+}
+
+// KeySchema KeySchema constrains the values of a key.
+type KeySchema struct {
+	// AllowedValues allowed_values are the only values accepted by an enum schema.
+	AllowedValues *[]string `json:"allowedValues,omitempty"`
+	Key           string    `json:"key"`
+
+	// MaxValues max_values is the maximum number of values of the key, zero for no limit.
+	MaxValues *uint32 `json:"maxValues,omitempty"`
+
+	// Pattern pattern is the RE2 regular expression a value must fully match in a regex schema. It is matched
+	//  against the value as written, so it is case-sensitive unlike the allowed_values.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Profile profile validates the key and its values, instead of the profile of the project.
+	Profile *KeySchemaProfile `json:"profile,omitempty"`
+	Type    *KeySchemaType    `json:"type,omitempty"`
+}
+
+// KeySchemaProfile profile validates the key and its values, instead of the profile of the project.
+type KeySchemaProfile string
+
+// KeySchemaType defines model for KeySchema.Type.
+type KeySchemaType string
+
+// KeySummary KeySummary is a key with its number of values.
+type KeySummary struct {
+	// DisplayKey display_key is the key as first written, when its casing differs from the normalized key.
+	DisplayKey *string `json:"displayKey,omitempty"`
+	Key        string  `json:"key"`
+	ValueCount uint32  `json:"valueCount"`
+}
+
+// ListAuditEventsResponse defines model for ListAuditEventsResponse.
+type ListAuditEventsResponse struct {
+	Events []AuditEvent `json:"events"`
+
+	// NextPageToken next_page_token requests the next page of events, it is empty on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// ListKeySchemasResponse defines model for ListKeySchemasResponse.
+type ListKeySchemasResponse struct {
+	Schemas []KeySchema `json:"schemas"`
+}
+
+// ListKeysResponse defines model for ListKeysResponse.
+type ListKeysResponse struct {
+	Keys []KeySummary `json:"keys"`
+
+	// Revision revision of the project metadata, also returned as the ETag header.
+	Revision *string `json:"revision,omitempty"`
+}
+
+// MergeValuesRequest defines model for MergeValuesRequest.
+type MergeValuesRequest struct {
+	// Into into is the value kept, it is created if missing.
+	Into string `json:"into"`
+	Key  string `json:"key"`
+
+	// Values values are merged into the value into and removed, together with their owners and sources.
+	Values []string `json:"values"`
+}
+
 // Metadata Metadata represents a single value of metadata.
 type Metadata struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	// Key key and value are matched case-insensitively, they are stored with the casing first written for display.
+	//  The characters and length accepted depend on the validation profile of the key, see ValidationProfile.
+	Key string `json:"key"`
+
+	// Owner owner acquires (on create) or releases (on delete) a reference to the value, e.g. app-orch/deployment/123.
+	Owner *string `json:"owner,omitempty"`
+
+	// Source source is the component writing the value, e.g. app-orch. It defaults to the product of the client User-Agent.
+	Source *string `json:"source,omitempty"`
+	Value  string  `json:"value"`
 }
 
 // MetadataList defines model for MetadataList.
@@ -20,20 +230,197 @@ type MetadataList struct {
 
 // MetadataResponse defines model for MetadataResponse.
 type MetadataResponse struct {
+	// Created created lists the entries of a CreateOrUpdateMetadata request that were not stored yet.
+	Created *[]Metadata `json:"created,omitempty"`
+
+	// Existing existing lists the entries of a CreateOrUpdateMetadata request that were already stored.
+	Existing *[]Metadata      `json:"existing,omitempty"`
 	Metadata []StoredMetadata `json:"metadata"`
+
+	// NextPageToken next_page_token requests the next page of a paged GetMetadata, it is empty on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// Revision revision of the project metadata, also returned as the ETag header.
+	Revision *string `json:"revision,omitempty"`
 }
 
-// StoredMetadata StoredMetadata represents all stored metadata values for a given key.
+// RenameKeyRequest defines model for RenameKeyRequest.
+type RenameKeyRequest struct {
+	Key    string `json:"key"`
+	NewKey string `json:"newKey"`
+}
+
+// SearchMatch SearchMatch is a key (with an empty value) or a value matching a search.
+type SearchMatch struct {
+	// DisplayKey display_key is the key as first written, when its casing differs from the normalized key.
+	DisplayKey *string `json:"displayKey,omitempty"`
+
+	// DisplayValue display_value is the value as first written, when its casing differs from the normalized value.
+	DisplayValue *string `json:"displayValue,omitempty"`
+
+	// Distance distance is the number of edits between the query and the closest part of the match, for fuzzy matches.
+	Distance *uint32 `json:"distance,omitempty"`
+
+	// Metadata Metadata represents a single value of metadata.
+	Metadata Metadata        `json:"metadata"`
+	Type     SearchMatchType `json:"type"`
+}
+
+// SearchMatchType defines model for SearchMatch.Type.
+type SearchMatchType string
+
+// SearchMetadataResponse defines model for SearchMetadataResponse.
+type SearchMetadataResponse struct {
+	Matches []SearchMatch `json:"matches"`
+}
+
+// SetValidationProfileRequest defines model for SetValidationProfileRequest.
+type SetValidationProfileRequest struct {
+	// Profile profile validates the keys and values of the project without a profile of their own, unspecified restores the default.
+	Profile *SetValidationProfileRequestProfile `json:"profile,omitempty"`
+}
+
+// SetValidationProfileRequestProfile profile validates the keys and values of the project without a profile of their own, unspecified restores the default.
+type SetValidationProfileRequestProfile string
+
+// StoredMetadata StoredMetadata represents all stored metadata values for a given key, in their normalized (lowercase) form.
 type StoredMetadata struct {
-	Key    string   `json:"key"`
-	Values []string `json:"values"`
+	// DisplayKey display_key is the key as first written, when its casing differs from the normalized key.
+	DisplayKey *string `json:"displayKey,omitempty"`
+
+	// DisplayValues display_values holds the values as first written, for the values whose casing differs from the normalized value.
+	DisplayValues *map[string]string `json:"displayValues,omitempty"`
+	Key           string             `json:"key"`
+
+	// RefCounts ref_counts holds the number of owners referencing each value, values without owners are omitted.
+	RefCounts *map[string]uint32 `json:"refCounts,omitempty"`
+	Values    []string           `json:"values"`
+}
+
+// ValidationProfileResponse defines model for ValidationProfileResponse.
+type ValidationProfileResponse struct {
+	Profile ValidationProfileResponseProfile `json:"profile"`
 }
 
+// ValidationProfileResponseProfile defines model for ValidationProfileResponse.Profile.
+type ValidationProfileResponseProfile string
+
 // MetadataServiceDeleteParams defines parameters for MetadataServiceDelete.
 type MetadataServiceDeleteParams struct {
+	// Key key and value are matched case-insensitively, they are stored with the casing first written for display.
+	//  The characters and length accepted depend on the validation profile of the key, see ValidationProfile.
 	Key   *string `form:"key,omitempty" json:"key,omitempty"`
 	Value *string `form:"value,omitempty" json:"value,omitempty"`
+
+	// Owner owner acquires (on create) or releases (on delete) a reference to the value, e.g. app-orch/deployment/123.
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Source source is the component writing the value, e.g. app-orch. It defaults to the product of the client User-Agent.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
 }
 
+// MetadataServiceGetMetadataParams defines parameters for MetadataServiceGetMetadata.
+type MetadataServiceGetMetadataParams struct {
+	// Source source only returns the values written by this component, e.g. app-orch.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// PageSize page_size is the maximum number of keys returned, at most 1000. Zero returns every key.
+	PageSize *uint32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// PageToken page_token is the next_page_token of the previous page, empty for the first page.
+	PageToken *string                                  `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+	OrderBy   *MetadataServiceGetMetadataParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+
+	// Keys keys only returns these keys.
+	Keys *[]string `form:"keys,omitempty" json:"keys,omitempty"`
+}
+
+// MetadataServiceGetMetadataParamsOrderBy defines parameters for MetadataServiceGetMetadata.
+type MetadataServiceGetMetadataParamsOrderBy string
+
+// MetadataServiceCreateOrUpdateMetadataParams defines parameters for MetadataServiceCreateOrUpdateMetadata.
+type MetadataServiceCreateOrUpdateMetadataParams struct {
+	// ExpectedRevision expected_revision, when set, fails the request with FAILED_PRECONDITION if the project is at another revision.
+	//  Zero expects a project that was never written, a deleted project keeps the revision it was deleted at.
+	ExpectedRevision *string `form:"expectedRevision,omitempty" json:"expectedRevision,omitempty"`
+}
+
+// MetadataServiceListAuditEventsParams defines parameters for MetadataServiceListAuditEvents.
+type MetadataServiceListAuditEventsParams struct {
+	// Key key only returns the events changing this key.
+	Key *string `form:"key,omitempty" json:"key,omitempty"`
+
+	// Subject subject only returns the events of this caller, the sub claim of its token.
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Rpc rpc only returns the events of this RPC, e.g. DeleteKey.
+	Rpc *string `form:"rpc,omitempty" json:"rpc,omitempty"`
+
+	// Since since only returns the events recorded at or after this time.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// PageSize page_size is the maximum number of events returned, at most 1000. Zero returns 100 events.
+	PageSize *uint32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// PageToken page_token is the next_page_token of the previous page, empty for the first page.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// MetadataServiceListKeysParams defines parameters for MetadataServiceListKeys.
+type MetadataServiceListKeysParams struct {
+	// Source source only returns the keys with values written by this component, counting only these values.
+	Source  *string                               `form:"source,omitempty" json:"source,omitempty"`
+	OrderBy *MetadataServiceListKeysParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+}
+
+// MetadataServiceListKeysParamsOrderBy defines parameters for MetadataServiceListKeys.
+type MetadataServiceListKeysParamsOrderBy string
+
+// MetadataServiceGetKeyParams defines parameters for MetadataServiceGetKey.
+type MetadataServiceGetKeyParams struct {
+	// Source source only returns the values written by this component, e.g. app-orch.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// OrderBy order_by sorts the values.
+	OrderBy *MetadataServiceGetKeyParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
+}
+
+// MetadataServiceGetKeyParamsOrderBy defines parameters for MetadataServiceGetKey.
+type MetadataServiceGetKeyParamsOrderBy string
+
+// MetadataServiceSearchMetadataParams defines parameters for MetadataServiceSearchMetadata.
+type MetadataServiceSearchMetadataParams struct {
+	// Query query is the partial key or value looked for, case-insensitively.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Key key only searches the values of this key, otherwise both the keys and the values are searched.
+	Key  *string                                  `form:"key,omitempty" json:"key,omitempty"`
+	Mode *MetadataServiceSearchMetadataParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Limit limit is the maximum number of matches returned, 20 by default and at most 1000.
+	Limit *uint32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Source source only searches the values written by this component, e.g. app-orch.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+}
+
+// MetadataServiceSearchMetadataParamsMode defines parameters for MetadataServiceSearchMetadata.
+type MetadataServiceSearchMetadataParamsMode string
+
 // MetadataServiceCreateOrUpdateMetadataJSONRequestBody defines body for MetadataServiceCreateOrUpdateMetadata for application/json ContentType.
 type MetadataServiceCreateOrUpdateMetadataJSONRequestBody = MetadataList
+
+// MetadataServiceBatchDeleteJSONRequestBody defines body for MetadataServiceBatchDelete for application/json ContentType.
+type MetadataServiceBatchDeleteJSONRequestBody = MetadataList
+
+// MetadataServiceMergeValuesJSONRequestBody defines body for MetadataServiceMergeValues for application/json ContentType.
+type MetadataServiceMergeValuesJSONRequestBody = MergeValuesRequest
+
+// MetadataServiceRenameKeyJSONRequestBody defines body for MetadataServiceRenameKey for application/json ContentType.
+type MetadataServiceRenameKeyJSONRequestBody = RenameKeyRequest
+
+// MetadataServiceSetKeySchemaJSONRequestBody defines body for MetadataServiceSetKeySchema for application/json ContentType.
+type MetadataServiceSetKeySchemaJSONRequestBody = KeySchema
+
+// MetadataServiceSetValidationProfileJSONRequestBody defines body for MetadataServiceSetValidationProfile for application/json ContentType.
+type MetadataServiceSetValidationProfileJSONRequestBody = SetValidationProfileRequest
@@ -18,7 +18,7 @@ func TestRestClientGet(t *testing.T) {
 	if err != nil {
 		t.Fatalf("%s", err.Error())
 	}
-	res, err := c.MetadataServiceGetMetadataWithResponse(context.TODO())
+	res, err := c.MetadataServiceGetMetadataWithResponse(context.TODO(), &client.MetadataServiceGetMetadataParams{})
 	if err != nil {
 		t.Fatalf("%s", err.Error())
 	}
@@ -31,7 +31,7 @@ func TestRestClientPost(t *testing.T) {
 	if err != nil {
 		t.Fatalf("%s", err.Error())
 	}
-	created, err := c.MetadataServiceCreateOrUpdateMetadataWithResponse(context.TODO(), client.MetadataServiceCreateOrUpdateMetadataJSONRequestBody{
+	created, err := c.MetadataServiceCreateOrUpdateMetadataWithResponse(context.TODO(), &client.MetadataServiceCreateOrUpdateMetadataParams{}, client.MetadataServiceCreateOrUpdateMetadataJSONRequestBody{
 		Metadata: []client.Metadata{
 			{Key: "customer", Value: "culvers"},
 			{Key: "customer", Value: "menards"},